@@ -1,5 +1,7 @@
 package kraken
 
+//go:generate go run ./internal/cmd/assetgen -assets testdata/asset_info.json -pairs testdata/asset_pairs.json -o assets_gen.go
+
 // Asset defines an asset within Kraken.
 type Asset string
 
@@ -54,9 +56,9 @@ type AssetPairInfo struct {
 	FeeVolumeCurrency  string     `json:"fee_volume_currency"`
 	MarginCall         int        `json:"margin_call"`
 	MarginStop         int        `json:"margin_stop"`
-	OrderMin           string     `json:"ordermin"`
-	CostMin            string     `json:"costmin"`
-	TickSize           string     `json:"tick_size"`
+	OrderMin           Decimal    `json:"ordermin"`
+	CostMin            Decimal    `json:"costmin"`
+	TickSize           Decimal    `json:"tick_size"`
 	Status             Status     `json:"status"`
 	LongPositionLimit  int        `json:"long_position_limit"`
 	ShortPositionLimit int        `json:"short_position_limit"`
@@ -70,952 +72,22 @@ func (a AssetPairs) Info(assetPair AssetPair) AssetPairInfo {
 	return a[assetPair]
 }
 
-const (
-	GST      Asset = "GST"
-	PHA      Asset = "PHA"
-	SBR      Asset = "SBR"
-	TRU      Asset = "TRU"
-	AIR      Asset = "AIR"
-	ALICE    Asset = "ALICE"
-	BNC      Asset = "BNC"
-	CTSI     Asset = "CTSI"
-	USDC     Asset = "USDC"
-	FLOWS    Asset = "FLOW.S"
-	GHST     Asset = "GHST"
-	XTZ      Asset = "XTZ"
-	BAL      Asset = "BAL"
-	DOT      Asset = "DOT"
-	FORTH    Asset = "FORTH"
-	YGG      Asset = "YGG"
-	STORJ    Asset = "STORJ"
-	USDCM    Asset = "USDC.M"
-	XLTC     Asset = "XLTC"
-	AUDHOLD  Asset = "AUD.HOLD"
-	INTR     Asset = "INTR"
-	OGN      Asset = "OGN"
-	SEI      Asset = "SEI"
-	EUL      Asset = "EUL"
-	GARI     Asset = "GARI"
-	MOVR     Asset = "MOVR"
-	TRIBE    Asset = "TRIBE"
-	FIL      Asset = "FIL"
-	SOL03S   Asset = "SOL03.S"
-	TVK      Asset = "TVK"
-	UNI      Asset = "UNI"
-	ADX      Asset = "ADX"
-	DOT28S   Asset = "DOT28.S"
-	SCRT     Asset = "SCRT"
-	AED      Asset = "AED"
-	SC       Asset = "SC"
-	XCN      Asset = "XCN"
-	ASTR     Asset = "ASTR"
-	EGLD     Asset = "EGLD"
-	KILT     Asset = "KILT"
-	SXP      Asset = "SXP"
-	AAVE     Asset = "AAVE"
-	UNFI     Asset = "UNFI"
-	SUI      Asset = "SUI"
-	ADA      Asset = "ADA"
-	EOS      Asset = "EOS"
-	GBPHOLD  Asset = "GBP.HOLD"
-	ICP      Asset = "ICP"
-	SCRTS    Asset = "SCRT.S"
-	XTZS     Asset = "XTZ.S"
-	ZCAD     Asset = "ZCAD"
-	CHF      Asset = "CHF"
-	DOTP     Asset = "DOT.P"
-	GMX      Asset = "GMX"
-	OXT      Asset = "OXT"
-	GMT      Asset = "GMT"
-	INJ      Asset = "INJ"
-	XREP     Asset = "XREP"
-	ANKR     Asset = "ANKR"
-	AXS      Asset = "AXS"
-	BLZ      Asset = "BLZ"
-	DASH     Asset = "DASH"
-	GRTS     Asset = "GRT.S"
-	PARA     Asset = "PARA"
-	WOO      Asset = "WOO"
-	ENS      Asset = "ENS"
-	GLMR     Asset = "GLMR"
-	NYM      Asset = "NYM"
-	XXBT     Asset = "XXBT"
-	APE      Asset = "APE"
-	FXS      Asset = "FXS"
-	USDHOLD  Asset = "USD.HOLD"
-	TRX      Asset = "TRX"
-	ONEINCH  Asset = "1INCH"
-	BOND     Asset = "BOND"
-	COMP     Asset = "COMP"
-	POND     Asset = "POND"
-	NEAR     Asset = "NEAR"
-	PSTAKE   Asset = "PSTAKE"
-	STEP     Asset = "STEP"
-	XBTM     Asset = "XBT.M"
-	API3     Asset = "API3"
-	KSMS     Asset = "KSM.S"
-	LSK      Asset = "LSK"
-	MASK     Asset = "MASK"
-	SUSHI    Asset = "SUSHI"
-	ZGBP     Asset = "ZGBP"
-	BTT      Asset = "BTT"
-	DENT     Asset = "DENT"
-	FET      Asset = "FET"
-	MC       Asset = "MC"
-	LUNA2    Asset = "LUNA2"
-	RPL      Asset = "RPL"
-	SRM      Asset = "SRM"
-	ENJ      Asset = "ENJ"
-	GALA     Asset = "GALA"
-	KAR      Asset = "KAR"
-	KAVA21S  Asset = "KAVA21.S"
-	DOTS     Asset = "DOT.S"
-	SUPER    Asset = "SUPER"
-	ZRX      Asset = "ZRX"
-	USDT     Asset = "USDT"
-	CHR      Asset = "CHR"
-	EURT     Asset = "EURT"
-	NODL     Asset = "NODL"
-	TBTC     Asset = "TBTC"
-	XXMR     Asset = "XXMR"
-	BCH      Asset = "BCH"
-	KFEE     Asset = "KFEE"
-	SYN      Asset = "SYN"
-	TEER     Asset = "TEER"
-	BAND     Asset = "BAND"
-	ICX      Asset = "ICX"
-	MINAS    Asset = "MINA.S"
-	HDX      Asset = "HDX"
-	RNDR     Asset = "RNDR"
-	USDM     Asset = "USD.M"
-	SDN      Asset = "SDN"
-	ETH2S    Asset = "ETH2.S"
-	FLOW     Asset = "FLOW"
-	LDO      Asset = "LDO"
-	LUNAS    Asset = "LUNA.S"
-	IDEX     Asset = "IDEX"
-	IMX      Asset = "IMX"
-	SHIB     Asset = "SHIB"
-	CHFHOLD  Asset = "CHF.HOLD"
-	PEPE     Asset = "PEPE"
-	XXRP     Asset = "XXRP"
-	XZEC     Asset = "XZEC"
-	BADGER   Asset = "BADGER"
-	CRV      Asset = "CRV"
-	ORCA     Asset = "ORCA"
-	ROOK     Asset = "ROOK"
-	BIT      Asset = "BIT"
-	MANA     Asset = "MANA"
-	YFI      Asset = "YFI"
-	TUSD     Asset = "TUSD"
-	FLOW14S  Asset = "FLOW14.S"
-	GTC      Asset = "GTC"
-	MATIC04S Asset = "MATIC04.S"
-	RBC      Asset = "RBC"
-	ALCX     Asset = "ALCX"
-	STX      Asset = "STX"
-	FIDA     Asset = "FIDA"
-	T        Asset = "T"
-	TOKE     Asset = "TOKE"
-	KNC      Asset = "KNC"
-	MIR      Asset = "MIR"
-	ALGO     Asset = "ALGO"
-	BAT      Asset = "BAT"
-	CELR     Asset = "CELR"
-	CQT      Asset = "CQT"
-	FIS      Asset = "FIS"
-	SOL      Asset = "SOL"
-	TLM      Asset = "TLM"
-	GAL      Asset = "GAL"
-	KSM07S   Asset = "KSM07.S"
-	LCX      Asset = "LCX"
-	PAXG     Asset = "PAXG"
-	AEDHOLD  Asset = "AED.HOLD"
-	MNGO     Asset = "MNGO"
-	ZAUD     Asset = "ZAUD"
-	ZUSD     Asset = "ZUSD"
-	RUNE     Asset = "RUNE"
-	XETC     Asset = "XETC"
-	ARPA     Asset = "ARPA"
-	LPT      Asset = "LPT"
-	PERP     Asset = "PERP"
-	QNT      Asset = "QNT"
-	ZEUR     Asset = "ZEUR"
-	ACH      Asset = "ACH"
-	ETH2     Asset = "ETH2"
-	NANO     Asset = "NANO"
-	UMA      Asset = "UMA"
-	STG      Asset = "STG"
-	LINK     Asset = "LINK"
-	LMWR     Asset = "LMWR"
-	REN      Asset = "REN"
-	REPV2    Asset = "REPV2"
-	KEEP     Asset = "KEEP"
-	KIN      Asset = "KIN"
-	MOON     Asset = "MOON"
-	MV       Asset = "MV"
-	AGLD     Asset = "AGLD"
-	AKT      Asset = "AKT"
-	ALGOS    Asset = "ALGO.S"
-	ATOM     Asset = "ATOM"
-	REQ      Asset = "REQ"
-	USDTM    Asset = "USDT.M"
-	CFG      Asset = "CFG"
-	JASMY    Asset = "JASMY"
-	KSM      Asset = "KSM"
-	MINA     Asset = "MINA"
-	KINT     Asset = "KINT"
-	SAMO     Asset = "SAMO"
-	SNX      Asset = "SNX"
-	XXLM     Asset = "XXLM"
-	ALPHA    Asset = "ALPHA"
-	EWT      Asset = "EWT"
-	FLOWH    Asset = "FLOWH"
-	GRT28S   Asset = "GRT28.S"
-	XRT      Asset = "XRT"
-	ANT      Asset = "ANT"
-	BNT      Asset = "BNT"
-	KSMP     Asset = "KSM.P"
-	SAND     Asset = "SAND"
-	WAVES    Asset = "WAVES"
-	ARB      Asset = "ARB"
-	BLUR     Asset = "BLUR"
-	BOBA     Asset = "BOBA"
-	PLA      Asset = "PLA"
-	ETHW     Asset = "ETHW"
-	SGB      Asset = "SGB"
-	WBTC     Asset = "WBTC"
-	XMLN     Asset = "XMLN"
-	ATOMS    Asset = "ATOM.S"
-	CADHOLD  Asset = "CAD.HOLD"
-	FLOWHS   Asset = "FLOWH.S"
-	FLRS     Asset = "FLR.S"
-	C98      Asset = "C98"
-	GRT      Asset = "GRT"
-	HFT      Asset = "HFT"
-	OCEAN    Asset = "OCEAN"
-	UST      Asset = "UST"
-	OMG      Asset = "OMG"
-	POLIS    Asset = "POLIS"
-	POLS     Asset = "POLS"
-	KEY      Asset = "KEY"
-	KP3R     Asset = "KP3R"
-	MATIC    Asset = "MATIC"
-	XXDG     Asset = "XXDG"
-	AVT      Asset = "AVT"
-	CVC      Asset = "CVC"
-	CVX      Asset = "CVX"
-	FARM     Asset = "FARM"
-	RLC      Asset = "RLC"
-	ADAS     Asset = "ADA.S"
-	EURM     Asset = "EUR.M"
-	FLR      Asset = "FLR"
-	KAVAS    Asset = "KAVA.S"
-	SCRT21S  Asset = "SCRT21.S"
-	TRXS     Asset = "TRX.S"
-	WETH     Asset = "WETH"
-	ATOM21S  Asset = "ATOM21.S"
-	MXC      Asset = "MXC"
-	OXY      Asset = "OXY"
-	POWR     Asset = "POWR"
-	JUNO     Asset = "JUNO"
-	MKR      Asset = "MKR"
-	BICO     Asset = "BICO"
-	BRICK    Asset = "BRICK"
-	COTI     Asset = "COTI"
-	CSM      Asset = "CSM"
-	APT      Asset = "APT"
-	ATLAS    Asset = "ATLAS"
-	WAXL     Asset = "WAXL"
-	BSX      Asset = "BSX"
-	FTM      Asset = "FTM"
-	MULTI    Asset = "MULTI"
-	SOLS     Asset = "SOL.S"
-	QTUM     Asset = "QTUM"
-	RARE     Asset = "RARE"
-	ACA      Asset = "ACA"
-	AVAX     Asset = "AVAX"
-	EURHOLD  Asset = "EUR.HOLD"
-	GNO      Asset = "GNO"
-	CHZ      Asset = "CHZ"
-	DYDX     Asset = "DYDX"
-	PYUSD    Asset = "PYUSD"
-	ZJPY     Asset = "ZJPY"
-	MSOL     Asset = "MSOL"
-	RAD      Asset = "RAD"
-	RARI     Asset = "RARI"
-	RAY      Asset = "RAY"
-	AUDIO    Asset = "AUDIO"
-	KAVA     Asset = "KAVA"
-	LUNA     Asset = "LUNA"
-	MATICS   Asset = "MATIC.S"
-	XETH     Asset = "XETH"
-	DAI      Asset = "DAI"
-	LRC      Asset = "LRC"
-	NMR      Asset = "NMR"
-	SPELL    Asset = "SPELL"
-)
+// RoundPrice rounds the price to the tick size of the pair, or to its
+// number of price decimals if the tick size is unknown.
+func (a AssetPairInfo) RoundPrice(price Decimal) Decimal {
+	if a.TickSize.Sign() > 0 {
+		return price.RoundToStep(a.TickSize)
+	}
+	return price.Round(int32(a.PairDecimals))
+}
 
-const (
-	PONDEUR    AssetPair = "PONDEUR"
-	QTUMUSD    AssetPair = "QTUMUSD"
-	RARIUSD    AssetPair = "RARIUSD"
-	BCHXBT     AssetPair = "BCHXBT"
-	ENJGBP     AssetPair = "ENJGBP"
-	KEEPXBT    AssetPair = "KEEPXBT"
-	OMGEUR     AssetPair = "OMGEUR"
-	OXYEUR     AssetPair = "OXYEUR"
-	XRPAUD     AssetPair = "XRPAUD"
-	RENUSD     AssetPair = "RENUSD"
-	USDCUSD    AssetPair = "USDCUSD"
-	XETCXXBT   AssetPair = "XETCXXBT"
-	APEEUR     AssetPair = "APEEUR"
-	EURCAD     AssetPair = "EURCAD"
-	FILETH     AssetPair = "FILETH"
-	JASMYUSD   AssetPair = "JASMYUSD"
-	LINKEUR    AssetPair = "LINKEUR"
-	REQEUR     AssetPair = "REQEUR"
-	RLCEUR     AssetPair = "RLCEUR"
-	STGEUR     AssetPair = "STGEUR"
-	ADXUSD     AssetPair = "ADXUSD"
-	API3EUR    AssetPair = "API3EUR"
-	AVAXEUR    AssetPair = "AVAXEUR"
-	BATXBT     AssetPair = "BATXBT"
-	RAREEUR    AssetPair = "RAREEUR"
-	BICOUSD    AssetPair = "BICOUSD"
-	CRVEUR     AssetPair = "CRVEUR"
-	SANDEUR    AssetPair = "SANDEUR"
-	LINKGBP    AssetPair = "LINKGBP"
-	XTZXBT     AssetPair = "XTZXBT"
-	ETHWETH    AssetPair = "ETHWETH"
-	GALEUR     AssetPair = "GALEUR"
-	SGBEUR     AssetPair = "SGBEUR"
-	ATOMEUR    AssetPair = "ATOMEUR"
-	BICOEUR    AssetPair = "BICOEUR"
-	COMPEUR    AssetPair = "COMPEUR"
-	DASHUSD    AssetPair = "DASHUSD"
-	EOSETH     AssetPair = "EOSETH"
-	AVAXUSDT   AssetPair = "AVAXUSDT"
-	LUNAEUR    AssetPair = "LUNAEUR"
-	NMREUR     AssetPair = "NMREUR"
-	XDGUSDT    AssetPair = "XDGUSDT"
-	SDNUSD     AssetPair = "SDNUSD"
-	EGLDEUR    AssetPair = "EGLDEUR"
-	EURTUSDT   AssetPair = "EURTUSDT"
-	JUNOUSD    AssetPair = "JUNOUSD"
-	LTCGBP     AssetPair = "LTCGBP"
-	POLISUSD   AssetPair = "POLISUSD"
-	STORJUSD   AssetPair = "STORJUSD"
-	TEEREUR    AssetPair = "TEEREUR"
-	WAVESEUR   AssetPair = "WAVESEUR"
-	CHZUSD     AssetPair = "CHZUSD"
-	LINKUSD    AssetPair = "LINKUSD"
-	LRCEUR     AssetPair = "LRCEUR"
-	OCEANUSD   AssetPair = "OCEANUSD"
-	OMGXBT     AssetPair = "OMGXBT"
-	XRTUSD     AssetPair = "XRTUSD"
-	AXSEUR     AssetPair = "AXSEUR"
-	MOONUSD    AssetPair = "MOONUSD"
-	MOVRUSD    AssetPair = "MOVRUSD"
-	STEPUSD    AssetPair = "STEPUSD"
-	REPV2XBT   AssetPair = "REPV2XBT"
-	RUNEEUR    AssetPair = "RUNEEUR"
-	SOLUSDT    AssetPair = "SOLUSDT"
-	ATOMUSD    AssetPair = "ATOMUSD"
-	BATUSD     AssetPair = "BATUSD"
-	BCHUSDT    AssetPair = "BCHUSDT"
-	ETH2SETH   AssetPair = "ETH2.SETH"
-	PEPEEUR    AssetPair = "PEPEEUR"
-	SOLXBT     AssetPair = "SOLXBT"
-	STXUSD     AssetPair = "STXUSD"
-	TBTCXBT    AssetPair = "TBTCXBT"
-	AKTUSD     AssetPair = "AKTUSD"
-	ATOMXBT    AssetPair = "ATOMXBT"
-	CELRUSD    AssetPair = "CELRUSD"
-	PAXGUSD    AssetPair = "PAXGUSD"
-	RAYUSD     AssetPair = "RAYUSD"
-	FORTHUSD   AssetPair = "FORTHUSD"
-	POLSEUR    AssetPair = "POLSEUR"
-	GLMREUR    AssetPair = "GLMREUR"
-	NANOXBT    AssetPair = "NANOXBT"
-	XTZETH     AssetPair = "XTZETH"
-	ALGOGBP    AssetPair = "ALGOGBP"
-	ATOMGBP    AssetPair = "ATOMGBP"
-	BALXBT     AssetPair = "BALXBT"
-	BLUREUR    AssetPair = "BLUREUR"
-	BLZUSD     AssetPair = "BLZUSD"
-	TRXUSD     AssetPair = "TRXUSD"
-	DOTUSD     AssetPair = "DOTUSD"
-	DYDXUSD    AssetPair = "DYDXUSD"
-	KNCEUR     AssetPair = "KNCEUR"
-	PHAUSD     AssetPair = "PHAUSD"
-	QTUMEUR    AssetPair = "QTUMEUR"
-	BLZEUR     AssetPair = "BLZEUR"
-	CHRUSD     AssetPair = "CHRUSD"
-	FXSUSD     AssetPair = "FXSUSD"
-	SCETH      AssetPair = "SCETH"
-	AUDIOUSD   AssetPair = "AUDIOUSD"
-	EURTEUR    AssetPair = "EURTEUR"
-	GLMRUSD    AssetPair = "GLMRUSD"
-	HFTEUR     AssetPair = "HFTEUR"
-	DAIUSD     AssetPair = "DAIUSD"
-	EURJPY     AssetPair = "EURJPY"
-	LPTEUR     AssetPair = "LPTEUR"
-	CVXEUR     AssetPair = "CVXEUR"
-	ENJJPY     AssetPair = "ENJJPY"
-	FXSEUR     AssetPair = "FXSEUR"
-	XXMRZEUR   AssetPair = "XXMRZEUR"
-	CRVUSD     AssetPair = "CRVUSD"
-	EGLDUSD    AssetPair = "EGLDUSD"
-	FLOWUSD    AssetPair = "FLOWUSD"
-	KSMUSD     AssetPair = "KSMUSD"
-	ORCAUSD    AssetPair = "ORCAUSD"
-	WAVESXBT   AssetPair = "WAVESXBT"
-	DAIUSDT    AssetPair = "DAIUSDT"
-	ENJXBT     AssetPair = "ENJXBT"
-	ICPEUR     AssetPair = "ICPEUR"
-	JUNOEUR    AssetPair = "JUNOEUR"
-	NANOETH    AssetPair = "NANOETH"
-	DOTETH     AssetPair = "DOTETH"
-	LDOEUR     AssetPair = "LDOEUR"
-	LUNA2EUR   AssetPair = "LUNA2EUR"
-	STGUSD     AssetPair = "STGUSD"
-	ACHEUR     AssetPair = "ACHEUR"
-	ATLASEUR   AssetPair = "ATLASEUR"
-	ALGOEUR    AssetPair = "ALGOEUR"
-	SUSHIEUR   AssetPair = "SUSHIEUR"
-	USDCCAD    AssetPair = "USDCCAD"
-	WBTCEUR    AssetPair = "WBTCEUR"
-	GALAEUR    AssetPair = "GALAEUR"
-	SNXXBT     AssetPair = "SNXXBT"
-	ATLASUSD   AssetPair = "ATLASUSD"
-	GARIEUR    AssetPair = "GARIEUR"
-	SRMUSD     AssetPair = "SRMUSD"
-	TOKEEUR    AssetPair = "TOKEEUR"
-	XTZUSDT    AssetPair = "XTZUSDT"
-	POLISEUR   AssetPair = "POLISEUR"
-	SPELLUSD   AssetPair = "SPELLUSD"
-	SRMEUR     AssetPair = "SRMEUR"
-	API3USD    AssetPair = "API3USD"
-	FARMUSD    AssetPair = "FARMUSD"
-	FIDAEUR    AssetPair = "FIDAEUR"
-	KILTUSD    AssetPair = "KILTUSD"
-	KNCXBT     AssetPair = "KNCXBT"
-	WAVESETH   AssetPair = "WAVESETH"
-	ADAEUR     AssetPair = "ADAEUR"
-	CHZEUR     AssetPair = "CHZEUR"
-	DASHXBT    AssetPair = "DASHXBT"
-	KAVAEUR    AssetPair = "KAVAEUR"
-	OMGETH     AssetPair = "OMGETH"
-	FLOWXBT    AssetPair = "FLOWXBT"
-	ICXXBT     AssetPair = "ICXXBT"
-	EWTUSD     AssetPair = "EWTUSD"
-	FTMUSD     AssetPair = "FTMUSD"
-	GRTEUR     AssetPair = "GRTEUR"
-	OXTUSD     AssetPair = "OXTUSD"
-	RENXBT     AssetPair = "RENXBT"
-	APTEUR     AssetPair = "APTEUR"
-	DYDXEUR    AssetPair = "DYDXEUR"
-	NYMEUR     AssetPair = "NYMEUR"
-	PHAEUR     AssetPair = "PHAEUR"
-	XXBTZGBP   AssetPair = "XXBTZGBP"
-	NYMUSD     AssetPair = "NYMUSD"
-	PLAUSD     AssetPair = "PLAUSD"
-	BCHGBP     AssetPair = "BCHGBP"
-	FILUSD     AssetPair = "FILUSD"
-	GALUSD     AssetPair = "GALUSD"
-	KP3RUSD    AssetPair = "KP3RUSD"
-	NEAREUR    AssetPair = "NEAREUR"
-	SHIBUSDT   AssetPair = "SHIBUSDT"
-	BITEUR     AssetPair = "BITEUR"
-	EWTEUR     AssetPair = "EWTEUR"
-	KINEUR     AssetPair = "KINEUR"
-	MKRXBT     AssetPair = "MKRXBT"
-	SANDGBP    AssetPair = "SANDGBP"
-	BITUSD     AssetPair = "BITUSD"
-	LUNAUSD    AssetPair = "LUNAUSD"
-	SCRTEUR    AssetPair = "SCRTEUR"
-	SOLGBP     AssetPair = "SOLGBP"
-	ADAXBT     AssetPair = "ADAXBT"
-	EURAUD     AssetPair = "EURAUD"
-	XTZGBP     AssetPair = "XTZGBP"
-	ETHDAI     AssetPair = "ETHDAI"
-	LTCUSDT    AssetPair = "LTCUSDT"
-	RBCEUR     AssetPair = "RBCEUR"
-	SUSHIGBP   AssetPair = "SUSHIGBP"
-	XTZEUR     AssetPair = "XTZEUR"
-	TRXETH     AssetPair = "TRXETH"
-	WAXLEUR    AssetPair = "WAXLEUR"
-	XBTDAI     AssetPair = "XBTDAI"
-	CTSIEUR    AssetPair = "CTSIEUR"
-	EOSXBT     AssetPair = "EOSXBT"
-	GNOXBT     AssetPair = "GNOXBT"
-	OGNEUR     AssetPair = "OGNEUR"
-	RPLUSD     AssetPair = "RPLUSD"
-	IDEXUSD    AssetPair = "IDEXUSD"
-	MINAUSD    AssetPair = "MINAUSD"
-	USDTAUD    AssetPair = "USDTAUD"
-	FISUSD     AssetPair = "FISUSD"
-	FLOWGBP    AssetPair = "FLOWGBP"
-	HDXEUR     AssetPair = "HDXEUR"
-	HDXUSD     AssetPair = "HDXUSD"
-	ICXUSD     AssetPair = "ICXUSD"
-	AAVEEUR    AssetPair = "AAVEEUR"
-	IDEXEUR    AssetPair = "IDEXEUR"
-	PARAUSD    AssetPair = "PARAUSD"
-	RARIXBT    AssetPair = "RARIXBT"
-	DOTJPY     AssetPair = "DOTJPY"
-	MATICEUR   AssetPair = "MATICEUR"
-	STEPEUR    AssetPair = "STEPEUR"
-	XXLMZGBP   AssetPair = "XXLMZGBP"
-	BOBAUSD    AssetPair = "BOBAUSD"
-	DOTXBT     AssetPair = "DOTXBT"
-	EURTUSD    AssetPair = "EURTUSD"
-	LTCAUD     AssetPair = "LTCAUD"
-	XCNUSD     AssetPair = "XCNUSD"
-	EULEUR     AssetPair = "EULEUR"
-	POLSUSD    AssetPair = "POLSUSD"
-	TRXXBT     AssetPair = "TRXXBT"
-	ZRXEUR     AssetPair = "ZRXEUR"
-	DENTEUR    AssetPair = "DENTEUR"
-	FLRUSD     AssetPair = "FLRUSD"
-	GSTUSD     AssetPair = "GSTUSD"
-	KEEPUSD    AssetPair = "KEEPUSD"
-	ARPAEUR    AssetPair = "ARPAEUR"
-	AUDJPY     AssetPair = "AUDJPY"
-	BATJPY     AssetPair = "BATJPY"
-	LUNA2USD   AssetPair = "LUNA2USD"
-	ZEURZUSD   AssetPair = "ZEURZUSD"
-	ALGOUSDT   AssetPair = "ALGOUSDT"
-	CQTEUR     AssetPair = "CQTEUR"
-	GMTUSD     AssetPair = "GMTUSD"
-	OGNUSD     AssetPair = "OGNUSD"
-	UNIXBT     AssetPair = "UNIXBT"
-	MIREUR     AssetPair = "MIREUR"
-	SYNEUR     AssetPair = "SYNEUR"
-	XXLMZEUR   AssetPair = "XXLMZEUR"
-	ETHUSDT    AssetPair = "ETHUSDT"
-	OCEANGBP   AssetPair = "OCEANGBP"
-	RNDRUSD    AssetPair = "RNDRUSD"
-	APEUSD     AssetPair = "APEUSD"
-	MANAUSD    AssetPair = "MANAUSD"
-	OCEANXBT   AssetPair = "OCEANXBT"
-	TLMEUR     AssetPair = "TLMEUR"
-	UMAUSD     AssetPair = "UMAUSD"
-	TOKEUSD    AssetPair = "TOKEUSD"
-	XCNEUR     AssetPair = "XCNEUR"
-	REPV2USD   AssetPair = "REPV2USD"
-	BRICKUSD   AssetPair = "BRICKUSD"
-	FARMEUR    AssetPair = "FARMEUR"
-	KINTUSD    AssetPair = "KINTUSD"
-	MCEUR      AssetPair = "MCEUR"
-	QTUMXBT    AssetPair = "QTUMXBT"
-	ASTREUR    AssetPair = "ASTREUR"
-	XBTCHF     AssetPair = "XBTCHF"
-	GHSTEUR    AssetPair = "GHSTEUR"
-	PAXGEUR    AssetPair = "PAXGEUR"
-	SRMXBT     AssetPair = "SRMXBT"
-	WAVESUSD   AssetPair = "WAVESUSD"
-	BALUSD     AssetPair = "BALUSD"
-	LMWREUR    AssetPair = "LMWREUR"
-	SUSHIXBT   AssetPair = "SUSHIXBT"
-	TVKEUR     AssetPair = "TVKEUR"
-	XZECXXBT   AssetPair = "XZECXXBT"
-	AIREUR     AssetPair = "AIREUR"
-	LSKUSD     AssetPair = "LSKUSD"
-	SBREUR     AssetPair = "SBREUR"
-	XREPXXBT   AssetPair = "XREPXXBT"
-	SUPEREUR   AssetPair = "SUPEREUR"
-	YFIXBT     AssetPair = "YFIXBT"
-	AGLDUSD    AssetPair = "AGLDUSD"
-	COMPXBT    AssetPair = "COMPXBT"
-	EOSEUR     AssetPair = "EOSEUR"
-	LTCETH     AssetPair = "LTCETH"
-	RAREUSD    AssetPair = "RAREUSD"
-	AGLDEUR    AssetPair = "AGLDEUR"
-	JASMYEUR   AssetPair = "JASMYEUR"
-	MASKUSD    AssetPair = "MASKUSD"
-	RENEUR     AssetPair = "RENEUR"
-	KEYEUR     AssetPair = "KEYEUR"
-	POWRUSD    AssetPair = "POWRUSD"
-	XLTCXXBT   AssetPair = "XLTCXXBT"
-	XRTEUR     AssetPair = "XRTEUR"
-	KSMEUR     AssetPair = "KSMEUR"
-	TBTCUSD    AssetPair = "TBTCUSD"
-	XLTCZUSD   AssetPair = "XLTCZUSD"
-	ADAUSDT    AssetPair = "ADAUSDT"
-	ARBEUR     AssetPair = "ARBEUR"
-	CRVETH     AssetPair = "CRVETH"
-	ICXETH     AssetPair = "ICXETH"
-	IMXEUR     AssetPair = "IMXEUR"
-	AKTEUR     AssetPair = "AKTEUR"
-	LPTXBT     AssetPair = "LPTXBT"
-	SDNEUR     AssetPair = "SDNEUR"
-	BCHETH     AssetPair = "BCHETH"
-	KNCUSD     AssetPair = "KNCUSD"
-	MATICGBP   AssetPair = "MATICGBP"
-	TRUUSD     AssetPair = "TRUUSD"
-	UNIEUR     AssetPair = "UNIEUR"
-	KILTEUR    AssetPair = "KILTEUR"
-	NMRUSD     AssetPair = "NMRUSD"
-	RADUSD     AssetPair = "RADUSD"
-	ONEINCHEUR AssetPair = "1INCHEUR"
-	AAVEXBT    AssetPair = "AAVEXBT"
-	BCHEUR     AssetPair = "BCHEUR"
-	C98EUR     AssetPair = "C98EUR"
-	ETHCHF     AssetPair = "ETHCHF"
-	XXDGXXBT   AssetPair = "XXDGXXBT"
-	ZRXXBT     AssetPair = "ZRXXBT"
-	GARIUSD    AssetPair = "GARIUSD"
-	NODLEUR    AssetPair = "NODLEUR"
-	UNIUSD     AssetPair = "UNIUSD"
-	USTUSDC    AssetPair = "USTUSDC"
-	XETHZGBP   AssetPair = "XETHZGBP"
-	SUIEUR     AssetPair = "SUIEUR"
-	BONDUSD    AssetPair = "BONDUSD"
-	BSXEUR     AssetPair = "BSXEUR"
-	CHREUR     AssetPair = "CHREUR"
-	DAIEUR     AssetPair = "DAIEUR"
-	DOTGBP     AssetPair = "DOTGBP"
-	ADAUSD     AssetPair = "ADAUSD"
-	FILEUR     AssetPair = "FILEUR"
-	INTREUR    AssetPair = "INTREUR"
-	PLAEUR     AssetPair = "PLAEUR"
-	SOLEUR     AssetPair = "SOLEUR"
-	ENSEUR     AssetPair = "ENSEUR"
-	REPV2EUR   AssetPair = "REPV2EUR"
-	ENJEUR     AssetPair = "ENJEUR"
-	EULUSD     AssetPair = "EULUSD"
-	ADAETH     AssetPair = "ADAETH"
-	ETHAUD     AssetPair = "ETHAUD"
-	FLOWEUR    AssetPair = "FLOWEUR"
-	YGGUSD     AssetPair = "YGGUSD"
-	UMAEUR     AssetPair = "UMAEUR"
-	XETCZEUR   AssetPair = "XETCZEUR"
-	AAVEETH    AssetPair = "AAVEETH"
-	CVCEUR     AssetPair = "CVCEUR"
-	FETEUR     AssetPair = "FETEUR"
-	OCEANEUR   AssetPair = "OCEANEUR"
-	COTIUSD    AssetPair = "COTIUSD"
-	MIRUSD     AssetPair = "MIRUSD"
-	WOOEUR     AssetPair = "WOOEUR"
-	MINAEUR    AssetPair = "MINAEUR"
-	AIRUSD     AssetPair = "AIRUSD"
-	BNCUSD     AssetPair = "BNCUSD"
-	CVXUSD     AssetPair = "CVXUSD"
-	FLREUR     AssetPair = "FLREUR"
-	GALAUSD    AssetPair = "GALAUSD"
-	BNTEUR     AssetPair = "BNTEUR"
-	KAVAETH    AssetPair = "KAVAETH"
-	RPLEUR     AssetPair = "RPLEUR"
-	XZECZEUR   AssetPair = "XZECZEUR"
-	YFIEUR     AssetPair = "YFIEUR"
-	ETHAED     AssetPair = "ETHAED"
-	SUPERUSD   AssetPair = "SUPERUSD"
-	USTUSD     AssetPair = "USTUSD"
-	ZRXUSD     AssetPair = "ZRXUSD"
-	BCHJPY     AssetPair = "BCHJPY"
-	FIDAUSD    AssetPair = "FIDAUSD"
-	KINTEUR    AssetPair = "KINTEUR"
-	LINKETH    AssetPair = "LINKETH"
-	SANDUSD    AssetPair = "SANDUSD"
-	ADAGBP     AssetPair = "ADAGBP"
-	ATOMETH    AssetPair = "ATOMETH"
-	EURGBP     AssetPair = "EURGBP"
-	FILGBP     AssetPair = "FILGBP"
-	USDTCAD    AssetPair = "USDTCAD"
-	SNXEUR     AssetPair = "SNXEUR"
-	SUIUSD     AssetPair = "SUIUSD"
-	CQTUSD     AssetPair = "CQTUSD"
-	LPTUSD     AssetPair = "LPTUSD"
-	MATICUSD   AssetPair = "MATICUSD"
-	MCUSD      AssetPair = "MCUSD"
-	REPV2ETH   AssetPair = "REPV2ETH"
-	ANKRXBT    AssetPair = "ANKRXBT"
-	KSMDOT     AssetPair = "KSMDOT"
-	LINKAUD    AssetPair = "LINKAUD"
-	QNTUSD     AssetPair = "QNTUSD"
-	SCUSD      AssetPair = "SCUSD"
-	EWTGBP     AssetPair = "EWTGBP"
-	SCRTUSD    AssetPair = "SCRTUSD"
-	SHIBUSD    AssetPair = "SHIBUSD"
-	ALGOETH    AssetPair = "ALGOETH"
-	BANDUSD    AssetPair = "BANDUSD"
-	BATEUR     AssetPair = "BATEUR"
-	COMPUSD    AssetPair = "COMPUSD"
-	COTIEUR    AssetPair = "COTIEUR"
-	STORJXBT   AssetPair = "STORJXBT"
-	USDTEUR    AssetPair = "USDTEUR"
-	XMLNXXBT   AssetPair = "XMLNXXBT"
-	MNGOEUR    AssetPair = "MNGOEUR"
-	USDTZUSD   AssetPair = "USDTZUSD"
-	ALICEUSD   AssetPair = "ALICEUSD"
-	ARPAUSD    AssetPair = "ARPAUSD"
-	BTTEUR     AssetPair = "BTTEUR"
-	GNOUSD     AssetPair = "GNOUSD"
-	INJEUR     AssetPair = "INJEUR"
-	ICPUSD     AssetPair = "ICPUSD"
-	IMXUSD     AssetPair = "IMXUSD"
-	MULTIEUR   AssetPair = "MULTIEUR"
-	USDCUSDT   AssetPair = "USDCUSDT"
-	XBTAUD     AssetPair = "XBTAUD"
-	LCXUSD     AssetPair = "LCXUSD"
-	LINKJPY    AssetPair = "LINKJPY"
-	MNGOUSD    AssetPair = "MNGOUSD"
-	SPELLEUR   AssetPair = "SPELLEUR"
-	XBTUSDT    AssetPair = "XBTUSDT"
-	YFIUSD     AssetPair = "YFIUSD"
-	BCHUSD     AssetPair = "BCHUSD"
-	CFGEUR     AssetPair = "CFGEUR"
-	PYUSDEUR   AssetPair = "PYUSDEUR"
-	QNTEUR     AssetPair = "QNTEUR"
-	USDTGBP    AssetPair = "USDTGBP"
-	SCEUR      AssetPair = "SCEUR"
-	TEUR       AssetPair = "TEUR"
-	ALGOXBT    AssetPair = "ALGOXBT"
-	ANTETH     AssetPair = "ANTETH"
-	BNTXBT     AssetPair = "BNTXBT"
-	C98USD     AssetPair = "C98USD"
-	PERPEUR    AssetPair = "PERPEUR"
-	TLMUSD     AssetPair = "TLMUSD"
-	UNFIEUR    AssetPair = "UNFIEUR"
-	USDTCHF    AssetPair = "USDTCHF"
-	ADAAUD     AssetPair = "ADAAUD"
-	APTUSD     AssetPair = "APTUSD"
-	BRICKEUR   AssetPair = "BRICKEUR"
-	KAVAUSD    AssetPair = "KAVAUSD"
-	PARAEUR    AssetPair = "PARAEUR"
-	LSKETH     AssetPair = "LSKETH"
-	SNXETH     AssetPair = "SNXETH"
-	USDCAUD    AssetPair = "USDCAUD"
-	STORJEUR   AssetPair = "STORJEUR"
-	STXEUR     AssetPair = "STXEUR"
-	EOSUSD     AssetPair = "EOSUSD"
-	LINKXBT    AssetPair = "LINKXBT"
-	NYMXBT     AssetPair = "NYMXBT"
-	REQUSD     AssetPair = "REQUSD"
-	RLCUSD     AssetPair = "RLCUSD"
-	KP3REUR    AssetPair = "KP3REUR"
-	TUSDUSD    AssetPair = "TUSDUSD"
-	USTEUR     AssetPair = "USTEUR"
-	WBTCUSD    AssetPair = "WBTCUSD"
-	BONDEUR    AssetPair = "BONDEUR"
-	KSMETH     AssetPair = "KSMETH"
-	LINKUSDT   AssetPair = "LINKUSDT"
-	MANAEUR    AssetPair = "MANAEUR"
-	OXTXBT     AssetPair = "OXTXBT"
-	XDGUSD     AssetPair = "XDGUSD"
-	XLTCZEUR   AssetPair = "XLTCZEUR"
-	ZUSDZJPY   AssetPair = "ZUSDZJPY"
-	ANKRUSD    AssetPair = "ANKRUSD"
-	EURCHF     AssetPair = "EURCHF"
-	GSTEUR     AssetPair = "GSTEUR"
-	MXCUSD     AssetPair = "MXCUSD"
-	TVKUSD     AssetPair = "TVKUSD"
-	TBTCEUR    AssetPair = "TBTCEUR"
-	XXBTZUSD   AssetPair = "XXBTZUSD"
-	ALICEEUR   AssetPair = "ALICEEUR"
-	BSXUSD     AssetPair = "BSXUSD"
-	CSMEUR     AssetPair = "CSMEUR"
-	ROOKEUR    AssetPair = "ROOKEUR"
-	SGBUSD     AssetPair = "SGBUSD"
-	WOOUSD     AssetPair = "WOOUSD"
-	ETHWEUR    AssetPair = "ETHWEUR"
-	HFTUSD     AssetPair = "HFTUSD"
-	LMWRUSD    AssetPair = "LMWRUSD"
-	SAMOEUR    AssetPair = "SAMOEUR"
-	SUSHIUSD   AssetPair = "SUSHIUSD"
-	BNTUSD     AssetPair = "BNTUSD"
-	ICXEUR     AssetPair = "ICXEUR"
-	USDTJPY    AssetPair = "USDTJPY"
-	XMRUSDT    AssetPair = "XMRUSDT"
-	XXRPZEUR   AssetPair = "XXRPZEUR"
-	ALCXUSD    AssetPair = "ALCXUSD"
-	FLOWETH    AssetPair = "FLOWETH"
-	GNOEUR     AssetPair = "GNOEUR"
-	SYNUSD     AssetPair = "SYNUSD"
-	TRXEUR     AssetPair = "TRXEUR"
-	ANTXBT     AssetPair = "ANTXBT"
-	ENSUSD     AssetPair = "ENSUSD"
-	QTUMETH    AssetPair = "QTUMETH"
-	XBTAED     AssetPair = "XBTAED"
-	ANTUSD     AssetPair = "ANTUSD"
-	RNDREUR    AssetPair = "RNDREUR"
-	SCXBT      AssetPair = "SCXBT"
-	SEIUSD     AssetPair = "SEIUSD"
-	XZECZUSD   AssetPair = "XZECZUSD"
-	RUNEUSD    AssetPair = "RUNEUSD"
-	UNIETH     AssetPair = "UNIETH"
-	XMLNXETH   AssetPair = "XMLNXETH"
-	ASTRUSD    AssetPair = "ASTRUSD"
-	CRVXBT     AssetPair = "CRVXBT"
-	KEEPEUR    AssetPair = "KEEPEUR"
-	KSMGBP     AssetPair = "KSMGBP"
-	PONDUSD    AssetPair = "PONDUSD"
-	XMLNZEUR   AssetPair = "XMLNZEUR"
-	ZGBPZUSD   AssetPair = "ZGBPZUSD"
-	AAVEGBP    AssetPair = "AAVEGBP"
-	BTTUSD     AssetPair = "BTTUSD"
-	MINAXBT    AssetPair = "MINAXBT"
-	PSTAKEUSD  AssetPair = "PSTAKEUSD"
-	RADEUR     AssetPair = "RADEUR"
-	AUDUSD     AssetPair = "AUDUSD"
-	CFGUSD     AssetPair = "CFGUSD"
-	PYUSDUSD   AssetPair = "PYUSDUSD"
-	RAYEUR     AssetPair = "RAYEUR"
-	XXBTZCAD   AssetPair = "XXBTZCAD"
-	AAVEUSD    AssetPair = "AAVEUSD"
-	KEYUSD     AssetPair = "KEYUSD"
-	MATICUSDT  AssetPair = "MATICUSDT"
-	TEERUSD    AssetPair = "TEERUSD"
-	XLTCZJPY   AssetPair = "XLTCZJPY"
-	UNFIUSD    AssetPair = "UNFIUSD"
-	ACHUSD     AssetPair = "ACHUSD"
-	EWTXBT     AssetPair = "EWTXBT"
-	KNCETH     AssetPair = "KNCETH"
-	MKRUSD     AssetPair = "MKRUSD"
-	MSOLEUR    AssetPair = "MSOLEUR"
-	ACAUSD     AssetPair = "ACAUSD"
-	AUDIOEUR   AssetPair = "AUDIOEUR"
-	ROOKUSD    AssetPair = "ROOKUSD"
-	SAMOUSD    AssetPair = "SAMOUSD"
-	XXRPXXBT   AssetPair = "XXRPXXBT"
-	TRUEUR     AssetPair = "TRUEUR"
-	XETHZCAD   AssetPair = "XETHZCAD"
-	XRPUSDT    AssetPair = "XRPUSDT"
-	MOONEUR    AssetPair = "MOONEUR"
-	OMGJPY     AssetPair = "OMGJPY"
-	ONEINCHUSD AssetPair = "1INCHUSD"
-	BANDEUR    AssetPair = "BANDEUR"
-	BLURUSD    AssetPair = "BLURUSD"
-	CTSIUSD    AssetPair = "CTSIUSD"
-	GMTEUR     AssetPair = "GMTEUR"
-	ETHWUSD    AssetPair = "ETHWUSD"
-	FORTHEUR   AssetPair = "FORTHEUR"
-	MANAUSDT   AssetPair = "MANAUSDT"
-	YGGEUR     AssetPair = "YGGEUR"
-	ACAEUR     AssetPair = "ACAEUR"
-	GRTGBP     AssetPair = "GRTGBP"
-	SHIBEUR    AssetPair = "SHIBEUR"
-	USDCHF     AssetPair = "USDCHF"
-	XXMRXXBT   AssetPair = "XXMRXXBT"
-	PEPEUSD    AssetPair = "PEPEUSD"
-	USDCEUR    AssetPair = "USDCEUR"
-	USDCGBP    AssetPair = "USDCGBP"
-	ALPHAEUR   AssetPair = "ALPHAEUR"
-	BCHAUD     AssetPair = "BCHAUD"
-	MATICXBT   AssetPair = "MATICXBT"
-	NANOUSD    AssetPair = "NANOUSD"
-	NODLUSD    AssetPair = "NODLUSD"
-	XXMRZUSD   AssetPair = "XXMRZUSD"
-	XXLMZUSD   AssetPair = "XXLMZUSD"
-	ARBUSD     AssetPair = "ARBUSD"
-	ENJUSD     AssetPair = "ENJUSD"
-	FTMEUR     AssetPair = "FTMEUR"
-	KINUSD     AssetPair = "KINUSD"
-	RARIEUR    AssetPair = "RARIEUR"
-	XXRPZUSD   AssetPair = "XXRPZUSD"
-	CVCUSD     AssetPair = "CVCUSD"
-	GMXUSD     AssetPair = "GMXUSD"
-	LPTGBP     AssetPair = "LPTGBP"
-	SBRUSD     AssetPair = "SBRUSD"
-	XXRPZJPY   AssetPair = "XXRPZJPY"
-	MSOLUSD    AssetPair = "MSOLUSD"
-	MVEUR      AssetPair = "MVEUR"
-	PAXGETH    AssetPair = "PAXGETH"
-	AVAXUSD    AssetPair = "AVAXUSD"
-	GMXEUR     AssetPair = "GMXEUR"
-	GRTUSD     AssetPair = "GRTUSD"
-	INTRUSD    AssetPair = "INTRUSD"
-	LDOUSD     AssetPair = "LDOUSD"
-	PAXGXBT    AssetPair = "PAXGXBT"
-	POWREUR    AssetPair = "POWREUR"
-	XDGEUR     AssetPair = "XDGEUR"
-	XRPETH     AssetPair = "XRPETH"
-	ZUSDZCAD   AssetPair = "ZUSDZCAD"
-	ORCAEUR    AssetPair = "ORCAEUR"
-	TUSDEUR    AssetPair = "TUSDEUR"
-	ANTEUR     AssetPair = "ANTEUR"
-	BALEUR     AssetPair = "BALEUR"
-	GRTXBT     AssetPair = "GRTXBT"
-	MXCEUR     AssetPair = "MXCEUR"
-	NEARUSD    AssetPair = "NEARUSD"
-	XXBTZJPY   AssetPair = "XXBTZJPY"
-	GHSTUSD    AssetPair = "GHSTUSD"
-	NANOEUR    AssetPair = "NANOEUR"
-	SANDXBT    AssetPair = "SANDXBT"
-	USDAED     AssetPair = "USDAED"
-	XREPZUSD   AssetPair = "XREPZUSD"
-	BADGERUSD  AssetPair = "BADGERUSD"
-	KAREUR     AssetPair = "KAREUR"
-	KSMXBT     AssetPair = "KSMXBT"
-	OXTEUR     AssetPair = "OXTEUR"
-	USDCCHF    AssetPair = "USDCCHF"
-	USTUSDT    AssetPair = "USTUSDT"
-	XMLNZUSD   AssetPair = "XMLNZUSD"
-	XXRPZCAD   AssetPair = "XXRPZCAD"
-	ALCXEUR    AssetPair = "ALCXEUR"
-	CELREUR    AssetPair = "CELREUR"
-	MANAXBT    AssetPair = "MANAXBT"
-	MVUSD      AssetPair = "MVUSD"
-	SNXUSD     AssetPair = "SNXUSD"
-	OMGUSD     AssetPair = "OMGUSD"
-	OXYUSD     AssetPair = "OXYUSD"
-	WAXLUSD    AssetPair = "WAXLUSD"
-	ALPHAUSD   AssetPair = "ALPHAUSD"
-	XETHZEUR   AssetPair = "XETHZEUR"
-	XRPGBP     AssetPair = "XRPGBP"
-	XTZUSD     AssetPair = "XTZUSD"
-	MULTIUSD   AssetPair = "MULTIUSD"
-	XETHZJPY   AssetPair = "XETHZJPY"
-	APEUSDT    AssetPair = "APEUSDT"
-	DASHEUR    AssetPair = "DASHEUR"
-	GHSTXBT    AssetPair = "GHSTXBT"
-	INJUSD     AssetPair = "INJUSD"
-	MINAGBP    AssetPair = "MINAGBP"
-	ATOMUSDT   AssetPair = "ATOMUSDT"
-	BOBAEUR    AssetPair = "BOBAEUR"
-	LSKEUR     AssetPair = "LSKEUR"
-	WBTCXBT    AssetPair = "WBTCXBT"
-	XETHXXBT   AssetPair = "XETHXXBT"
-	FILXBT     AssetPair = "FILXBT"
-	MKREUR     AssetPair = "MKREUR"
-	RBCUSD     AssetPair = "RBCUSD"
-	XBTUSDC    AssetPair = "XBTUSDC"
-	PSTAKEEUR  AssetPair = "PSTAKEEUR"
-	XETCXETH   AssetPair = "XETCXETH"
-	XREPZEUR   AssetPair = "XREPZEUR"
-	ADXEUR     AssetPair = "ADXEUR"
-	ANKREUR    AssetPair = "ANKREUR"
-	DENTUSD    AssetPair = "DENTUSD"
-	FISEUR     AssetPair = "FISEUR"
-	MANAETH    AssetPair = "MANAETH"
-	XETCZUSD   AssetPair = "XETCZUSD"
-	ALGOUSD    AssetPair = "ALGOUSD"
-	BADGEREUR  AssetPair = "BADGEREUR"
-	BATETH     AssetPair = "BATETH"
-	GTCEUR     AssetPair = "GTCEUR"
-	MOVREUR    AssetPair = "MOVREUR"
-	DOTUSDT    AssetPair = "DOTUSDT"
-	EOSUSDT    AssetPair = "EOSUSDT"
-	ETHUSDC    AssetPair = "ETHUSDC"
-	FETUSD     AssetPair = "FETUSD"
-	SOLUSD     AssetPair = "SOLUSD"
-	XXBTZEUR   AssetPair = "XXBTZEUR"
-	KARUSD     AssetPair = "KARUSD"
-	KAVAXBT    AssetPair = "KAVAXBT"
-	LCXEUR     AssetPair = "LCXEUR"
-	LRCUSD     AssetPair = "LRCUSD"
-	LSKXBT     AssetPair = "LSKXBT"
-	XXLMXXBT   AssetPair = "XXLMXXBT"
-	CSMUSD     AssetPair = "CSMUSD"
-	GTCUSD     AssetPair = "GTCUSD"
-	MASKEUR    AssetPair = "MASKEUR"
-	XETHZUSD   AssetPair = "XETHZUSD"
-	XTZAUD     AssetPair = "XTZAUD"
-	AXSUSD     AssetPair = "AXSUSD"
-	BNCEUR     AssetPair = "BNCEUR"
-	SEIEUR     AssetPair = "SEIEUR"
-	DOTEUR     AssetPair = "DOTEUR"
-	PERPUSD    AssetPair = "PERPUSD"
-)
+// RoundVolume truncates the volume to the number of lot decimals of the pair,
+// so that the rounded volume never exceeds the original one.
+func (a AssetPairInfo) RoundVolume(volume Decimal) Decimal {
+	return volume.Truncate(int32(a.LotDecimals))
+}
+
+// RoundCost rounds the cost to the number of cost decimals of the pair.
+func (a AssetPairInfo) RoundCost(cost Decimal) Decimal {
+	return cost.Round(int32(a.CostDecimals))
+}
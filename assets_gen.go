@@ -0,0 +1,1911 @@
+// Code generated by assetgen from 283 assets and 662 asset pairs. DO NOT EDIT.
+
+package kraken
+
+// Assets available on Kraken.
+const (
+	ONEINCH  Asset = "1INCH"
+	AAVE     Asset = "AAVE"
+	ACA      Asset = "ACA"
+	ACH      Asset = "ACH"
+	ADA      Asset = "ADA"
+	ADAS     Asset = "ADA.S"
+	ADX      Asset = "ADX"
+	AED      Asset = "AED"
+	AEDHOLD  Asset = "AED.HOLD"
+	AGLD     Asset = "AGLD"
+	AIR      Asset = "AIR"
+	AKT      Asset = "AKT"
+	ALCX     Asset = "ALCX"
+	ALGO     Asset = "ALGO"
+	ALGOS    Asset = "ALGO.S"
+	ALICE    Asset = "ALICE"
+	ALPHA    Asset = "ALPHA"
+	ANKR     Asset = "ANKR"
+	ANT      Asset = "ANT"
+	APE      Asset = "APE"
+	API3     Asset = "API3"
+	APT      Asset = "APT"
+	ARB      Asset = "ARB"
+	ARPA     Asset = "ARPA"
+	ASTR     Asset = "ASTR"
+	ATLAS    Asset = "ATLAS"
+	ATOM     Asset = "ATOM"
+	ATOMS    Asset = "ATOM.S"
+	ATOM21S  Asset = "ATOM21.S"
+	AUDHOLD  Asset = "AUD.HOLD"
+	AUDIO    Asset = "AUDIO"
+	AVAX     Asset = "AVAX"
+	AVT      Asset = "AVT"
+	AXS      Asset = "AXS"
+	BADGER   Asset = "BADGER"
+	BAL      Asset = "BAL"
+	BAND     Asset = "BAND"
+	BAT      Asset = "BAT"
+	BCH      Asset = "BCH"
+	BICO     Asset = "BICO"
+	BIT      Asset = "BIT"
+	BLUR     Asset = "BLUR"
+	BLZ      Asset = "BLZ"
+	BNC      Asset = "BNC"
+	BNT      Asset = "BNT"
+	BOBA     Asset = "BOBA"
+	BOND     Asset = "BOND"
+	BRICK    Asset = "BRICK"
+	BSX      Asset = "BSX"
+	BTT      Asset = "BTT"
+	C98      Asset = "C98"
+	CADHOLD  Asset = "CAD.HOLD"
+	CELR     Asset = "CELR"
+	CFG      Asset = "CFG"
+	CHF      Asset = "CHF"
+	CHFHOLD  Asset = "CHF.HOLD"
+	CHR      Asset = "CHR"
+	CHZ      Asset = "CHZ"
+	COMP     Asset = "COMP"
+	COTI     Asset = "COTI"
+	CQT      Asset = "CQT"
+	CRV      Asset = "CRV"
+	CSM      Asset = "CSM"
+	CTSI     Asset = "CTSI"
+	CVC      Asset = "CVC"
+	CVX      Asset = "CVX"
+	DAI      Asset = "DAI"
+	DASH     Asset = "DASH"
+	DENT     Asset = "DENT"
+	DOT      Asset = "DOT"
+	DOTP     Asset = "DOT.P"
+	DOTS     Asset = "DOT.S"
+	DOT28S   Asset = "DOT28.S"
+	DYDX     Asset = "DYDX"
+	EGLD     Asset = "EGLD"
+	ENJ      Asset = "ENJ"
+	ENS      Asset = "ENS"
+	EOS      Asset = "EOS"
+	ETH2     Asset = "ETH2"
+	ETH2S    Asset = "ETH2.S"
+	ETHW     Asset = "ETHW"
+	EUL      Asset = "EUL"
+	EURHOLD  Asset = "EUR.HOLD"
+	EURM     Asset = "EUR.M"
+	EURT     Asset = "EURT"
+	EWT      Asset = "EWT"
+	FARM     Asset = "FARM"
+	FET      Asset = "FET"
+	FIDA     Asset = "FIDA"
+	FIL      Asset = "FIL"
+	FIS      Asset = "FIS"
+	FLOW     Asset = "FLOW"
+	FLOWS    Asset = "FLOW.S"
+	FLOW14S  Asset = "FLOW14.S"
+	FLOWH    Asset = "FLOWH"
+	FLOWHS   Asset = "FLOWH.S"
+	FLR      Asset = "FLR"
+	FLRS     Asset = "FLR.S"
+	FORTH    Asset = "FORTH"
+	FTM      Asset = "FTM"
+	FXS      Asset = "FXS"
+	GAL      Asset = "GAL"
+	GALA     Asset = "GALA"
+	GARI     Asset = "GARI"
+	GBPHOLD  Asset = "GBP.HOLD"
+	GHST     Asset = "GHST"
+	GLMR     Asset = "GLMR"
+	GMT      Asset = "GMT"
+	GMX      Asset = "GMX"
+	GNO      Asset = "GNO"
+	GRT      Asset = "GRT"
+	GRTS     Asset = "GRT.S"
+	GRT28S   Asset = "GRT28.S"
+	GST      Asset = "GST"
+	GTC      Asset = "GTC"
+	HDX      Asset = "HDX"
+	HFT      Asset = "HFT"
+	ICP      Asset = "ICP"
+	ICX      Asset = "ICX"
+	IDEX     Asset = "IDEX"
+	IMX      Asset = "IMX"
+	INJ      Asset = "INJ"
+	INTR     Asset = "INTR"
+	JASMY    Asset = "JASMY"
+	JUNO     Asset = "JUNO"
+	KAR      Asset = "KAR"
+	KAVA     Asset = "KAVA"
+	KAVAS    Asset = "KAVA.S"
+	KAVA21S  Asset = "KAVA21.S"
+	KEEP     Asset = "KEEP"
+	KEY      Asset = "KEY"
+	KFEE     Asset = "KFEE"
+	KILT     Asset = "KILT"
+	KIN      Asset = "KIN"
+	KINT     Asset = "KINT"
+	KNC      Asset = "KNC"
+	KP3R     Asset = "KP3R"
+	KSM      Asset = "KSM"
+	KSMP     Asset = "KSM.P"
+	KSMS     Asset = "KSM.S"
+	KSM07S   Asset = "KSM07.S"
+	LCX      Asset = "LCX"
+	LDO      Asset = "LDO"
+	LINK     Asset = "LINK"
+	LMWR     Asset = "LMWR"
+	LPT      Asset = "LPT"
+	LRC      Asset = "LRC"
+	LSK      Asset = "LSK"
+	LUNA     Asset = "LUNA"
+	LUNAS    Asset = "LUNA.S"
+	LUNA2    Asset = "LUNA2"
+	MANA     Asset = "MANA"
+	MASK     Asset = "MASK"
+	MATIC    Asset = "MATIC"
+	MATICS   Asset = "MATIC.S"
+	MATIC04S Asset = "MATIC04.S"
+	MC       Asset = "MC"
+	MINA     Asset = "MINA"
+	MINAS    Asset = "MINA.S"
+	MIR      Asset = "MIR"
+	MKR      Asset = "MKR"
+	MNGO     Asset = "MNGO"
+	MOON     Asset = "MOON"
+	MOVR     Asset = "MOVR"
+	MSOL     Asset = "MSOL"
+	MULTI    Asset = "MULTI"
+	MV       Asset = "MV"
+	MXC      Asset = "MXC"
+	NANO     Asset = "NANO"
+	NEAR     Asset = "NEAR"
+	NMR      Asset = "NMR"
+	NODL     Asset = "NODL"
+	NYM      Asset = "NYM"
+	OCEAN    Asset = "OCEAN"
+	OGN      Asset = "OGN"
+	OMG      Asset = "OMG"
+	ORCA     Asset = "ORCA"
+	OXT      Asset = "OXT"
+	OXY      Asset = "OXY"
+	PARA     Asset = "PARA"
+	PAXG     Asset = "PAXG"
+	PEPE     Asset = "PEPE"
+	PERP     Asset = "PERP"
+	PHA      Asset = "PHA"
+	PLA      Asset = "PLA"
+	POLIS    Asset = "POLIS"
+	POLS     Asset = "POLS"
+	POND     Asset = "POND"
+	POWR     Asset = "POWR"
+	PSTAKE   Asset = "PSTAKE"
+	PYUSD    Asset = "PYUSD"
+	QNT      Asset = "QNT"
+	QTUM     Asset = "QTUM"
+	RAD      Asset = "RAD"
+	RARE     Asset = "RARE"
+	RARI     Asset = "RARI"
+	RAY      Asset = "RAY"
+	RBC      Asset = "RBC"
+	REN      Asset = "REN"
+	REPV2    Asset = "REPV2"
+	REQ      Asset = "REQ"
+	RLC      Asset = "RLC"
+	RNDR     Asset = "RNDR"
+	ROOK     Asset = "ROOK"
+	RPL      Asset = "RPL"
+	RUNE     Asset = "RUNE"
+	SAMO     Asset = "SAMO"
+	SAND     Asset = "SAND"
+	SBR      Asset = "SBR"
+	SC       Asset = "SC"
+	SCRT     Asset = "SCRT"
+	SCRTS    Asset = "SCRT.S"
+	SCRT21S  Asset = "SCRT21.S"
+	SDN      Asset = "SDN"
+	SEI      Asset = "SEI"
+	SGB      Asset = "SGB"
+	SHIB     Asset = "SHIB"
+	SNX      Asset = "SNX"
+	SOL      Asset = "SOL"
+	SOLS     Asset = "SOL.S"
+	SOL03S   Asset = "SOL03.S"
+	SPELL    Asset = "SPELL"
+	SRM      Asset = "SRM"
+	STEP     Asset = "STEP"
+	STG      Asset = "STG"
+	STORJ    Asset = "STORJ"
+	STX      Asset = "STX"
+	SUI      Asset = "SUI"
+	SUPER    Asset = "SUPER"
+	SUSHI    Asset = "SUSHI"
+	SXP      Asset = "SXP"
+	SYN      Asset = "SYN"
+	T        Asset = "T"
+	TBTC     Asset = "TBTC"
+	TEER     Asset = "TEER"
+	TLM      Asset = "TLM"
+	TOKE     Asset = "TOKE"
+	TRIBE    Asset = "TRIBE"
+	TRU      Asset = "TRU"
+	TRX      Asset = "TRX"
+	TRXS     Asset = "TRX.S"
+	TUSD     Asset = "TUSD"
+	TVK      Asset = "TVK"
+	UMA      Asset = "UMA"
+	UNFI     Asset = "UNFI"
+	UNI      Asset = "UNI"
+	USDHOLD  Asset = "USD.HOLD"
+	USDM     Asset = "USD.M"
+	USDC     Asset = "USDC"
+	USDCM    Asset = "USDC.M"
+	USDT     Asset = "USDT"
+	USDTM    Asset = "USDT.M"
+	UST      Asset = "UST"
+	WAVES    Asset = "WAVES"
+	WAXL     Asset = "WAXL"
+	WBTC     Asset = "WBTC"
+	WETH     Asset = "WETH"
+	WOO      Asset = "WOO"
+	XBTM     Asset = "XBT.M"
+	XCN      Asset = "XCN"
+	XETC     Asset = "XETC"
+	XETH     Asset = "XETH"
+	XLTC     Asset = "XLTC"
+	XMLN     Asset = "XMLN"
+	XREP     Asset = "XREP"
+	XRT      Asset = "XRT"
+	XTZ      Asset = "XTZ"
+	XTZS     Asset = "XTZ.S"
+	XXBT     Asset = "XXBT"
+	XXDG     Asset = "XXDG"
+	XXLM     Asset = "XXLM"
+	XXMR     Asset = "XXMR"
+	XXRP     Asset = "XXRP"
+	XZEC     Asset = "XZEC"
+	YFI      Asset = "YFI"
+	YGG      Asset = "YGG"
+	ZAUD     Asset = "ZAUD"
+	ZCAD     Asset = "ZCAD"
+	ZEUR     Asset = "ZEUR"
+	ZGBP     Asset = "ZGBP"
+	ZJPY     Asset = "ZJPY"
+	ZRX      Asset = "ZRX"
+	ZUSD     Asset = "ZUSD"
+)
+
+// Asset pairs available on Kraken.
+const (
+	ONEINCHEUR AssetPair = "1INCHEUR"
+	ONEINCHUSD AssetPair = "1INCHUSD"
+	AAVEETH    AssetPair = "AAVEETH"
+	AAVEEUR    AssetPair = "AAVEEUR"
+	AAVEGBP    AssetPair = "AAVEGBP"
+	AAVEUSD    AssetPair = "AAVEUSD"
+	AAVEXBT    AssetPair = "AAVEXBT"
+	ACAEUR     AssetPair = "ACAEUR"
+	ACAUSD     AssetPair = "ACAUSD"
+	ACHEUR     AssetPair = "ACHEUR"
+	ACHUSD     AssetPair = "ACHUSD"
+	ADAAUD     AssetPair = "ADAAUD"
+	ADAETH     AssetPair = "ADAETH"
+	ADAEUR     AssetPair = "ADAEUR"
+	ADAGBP     AssetPair = "ADAGBP"
+	ADAUSD     AssetPair = "ADAUSD"
+	ADAUSDT    AssetPair = "ADAUSDT"
+	ADAXBT     AssetPair = "ADAXBT"
+	ADXEUR     AssetPair = "ADXEUR"
+	ADXUSD     AssetPair = "ADXUSD"
+	AGLDEUR    AssetPair = "AGLDEUR"
+	AGLDUSD    AssetPair = "AGLDUSD"
+	AIREUR     AssetPair = "AIREUR"
+	AIRUSD     AssetPair = "AIRUSD"
+	AKTEUR     AssetPair = "AKTEUR"
+	AKTUSD     AssetPair = "AKTUSD"
+	ALCXEUR    AssetPair = "ALCXEUR"
+	ALCXUSD    AssetPair = "ALCXUSD"
+	ALGOETH    AssetPair = "ALGOETH"
+	ALGOEUR    AssetPair = "ALGOEUR"
+	ALGOGBP    AssetPair = "ALGOGBP"
+	ALGOUSD    AssetPair = "ALGOUSD"
+	ALGOUSDT   AssetPair = "ALGOUSDT"
+	ALGOXBT    AssetPair = "ALGOXBT"
+	ALICEEUR   AssetPair = "ALICEEUR"
+	ALICEUSD   AssetPair = "ALICEUSD"
+	ALPHAEUR   AssetPair = "ALPHAEUR"
+	ALPHAUSD   AssetPair = "ALPHAUSD"
+	ANKREUR    AssetPair = "ANKREUR"
+	ANKRUSD    AssetPair = "ANKRUSD"
+	ANKRXBT    AssetPair = "ANKRXBT"
+	ANTETH     AssetPair = "ANTETH"
+	ANTEUR     AssetPair = "ANTEUR"
+	ANTUSD     AssetPair = "ANTUSD"
+	ANTXBT     AssetPair = "ANTXBT"
+	APEEUR     AssetPair = "APEEUR"
+	APEUSD     AssetPair = "APEUSD"
+	APEUSDT    AssetPair = "APEUSDT"
+	API3EUR    AssetPair = "API3EUR"
+	API3USD    AssetPair = "API3USD"
+	APTEUR     AssetPair = "APTEUR"
+	APTUSD     AssetPair = "APTUSD"
+	ARBEUR     AssetPair = "ARBEUR"
+	ARBUSD     AssetPair = "ARBUSD"
+	ARPAEUR    AssetPair = "ARPAEUR"
+	ARPAUSD    AssetPair = "ARPAUSD"
+	ASTREUR    AssetPair = "ASTREUR"
+	ASTRUSD    AssetPair = "ASTRUSD"
+	ATLASEUR   AssetPair = "ATLASEUR"
+	ATLASUSD   AssetPair = "ATLASUSD"
+	ATOMETH    AssetPair = "ATOMETH"
+	ATOMEUR    AssetPair = "ATOMEUR"
+	ATOMGBP    AssetPair = "ATOMGBP"
+	ATOMUSD    AssetPair = "ATOMUSD"
+	ATOMUSDT   AssetPair = "ATOMUSDT"
+	ATOMXBT    AssetPair = "ATOMXBT"
+	AUDIOEUR   AssetPair = "AUDIOEUR"
+	AUDIOUSD   AssetPair = "AUDIOUSD"
+	AUDJPY     AssetPair = "AUDJPY"
+	AUDUSD     AssetPair = "AUDUSD"
+	AVAXEUR    AssetPair = "AVAXEUR"
+	AVAXUSD    AssetPair = "AVAXUSD"
+	AVAXUSDT   AssetPair = "AVAXUSDT"
+	AXSEUR     AssetPair = "AXSEUR"
+	AXSUSD     AssetPair = "AXSUSD"
+	BADGEREUR  AssetPair = "BADGEREUR"
+	BADGERUSD  AssetPair = "BADGERUSD"
+	BALEUR     AssetPair = "BALEUR"
+	BALUSD     AssetPair = "BALUSD"
+	BALXBT     AssetPair = "BALXBT"
+	BANDEUR    AssetPair = "BANDEUR"
+	BANDUSD    AssetPair = "BANDUSD"
+	BATETH     AssetPair = "BATETH"
+	BATEUR     AssetPair = "BATEUR"
+	BATJPY     AssetPair = "BATJPY"
+	BATUSD     AssetPair = "BATUSD"
+	BATXBT     AssetPair = "BATXBT"
+	BCHAUD     AssetPair = "BCHAUD"
+	BCHETH     AssetPair = "BCHETH"
+	BCHEUR     AssetPair = "BCHEUR"
+	BCHGBP     AssetPair = "BCHGBP"
+	BCHJPY     AssetPair = "BCHJPY"
+	BCHUSD     AssetPair = "BCHUSD"
+	BCHUSDT    AssetPair = "BCHUSDT"
+	BCHXBT     AssetPair = "BCHXBT"
+	BICOEUR    AssetPair = "BICOEUR"
+	BICOUSD    AssetPair = "BICOUSD"
+	BITEUR     AssetPair = "BITEUR"
+	BITUSD     AssetPair = "BITUSD"
+	BLUREUR    AssetPair = "BLUREUR"
+	BLURUSD    AssetPair = "BLURUSD"
+	BLZEUR     AssetPair = "BLZEUR"
+	BLZUSD     AssetPair = "BLZUSD"
+	BNCEUR     AssetPair = "BNCEUR"
+	BNCUSD     AssetPair = "BNCUSD"
+	BNTEUR     AssetPair = "BNTEUR"
+	BNTUSD     AssetPair = "BNTUSD"
+	BNTXBT     AssetPair = "BNTXBT"
+	BOBAEUR    AssetPair = "BOBAEUR"
+	BOBAUSD    AssetPair = "BOBAUSD"
+	BONDEUR    AssetPair = "BONDEUR"
+	BONDUSD    AssetPair = "BONDUSD"
+	BRICKEUR   AssetPair = "BRICKEUR"
+	BRICKUSD   AssetPair = "BRICKUSD"
+	BSXEUR     AssetPair = "BSXEUR"
+	BSXUSD     AssetPair = "BSXUSD"
+	BTTEUR     AssetPair = "BTTEUR"
+	BTTUSD     AssetPair = "BTTUSD"
+	C98EUR     AssetPair = "C98EUR"
+	C98USD     AssetPair = "C98USD"
+	CELREUR    AssetPair = "CELREUR"
+	CELRUSD    AssetPair = "CELRUSD"
+	CFGEUR     AssetPair = "CFGEUR"
+	CFGUSD     AssetPair = "CFGUSD"
+	CHREUR     AssetPair = "CHREUR"
+	CHRUSD     AssetPair = "CHRUSD"
+	CHZEUR     AssetPair = "CHZEUR"
+	CHZUSD     AssetPair = "CHZUSD"
+	COMPEUR    AssetPair = "COMPEUR"
+	COMPUSD    AssetPair = "COMPUSD"
+	COMPXBT    AssetPair = "COMPXBT"
+	COTIEUR    AssetPair = "COTIEUR"
+	COTIUSD    AssetPair = "COTIUSD"
+	CQTEUR     AssetPair = "CQTEUR"
+	CQTUSD     AssetPair = "CQTUSD"
+	CRVETH     AssetPair = "CRVETH"
+	CRVEUR     AssetPair = "CRVEUR"
+	CRVUSD     AssetPair = "CRVUSD"
+	CRVXBT     AssetPair = "CRVXBT"
+	CSMEUR     AssetPair = "CSMEUR"
+	CSMUSD     AssetPair = "CSMUSD"
+	CTSIEUR    AssetPair = "CTSIEUR"
+	CTSIUSD    AssetPair = "CTSIUSD"
+	CVCEUR     AssetPair = "CVCEUR"
+	CVCUSD     AssetPair = "CVCUSD"
+	CVXEUR     AssetPair = "CVXEUR"
+	CVXUSD     AssetPair = "CVXUSD"
+	DAIEUR     AssetPair = "DAIEUR"
+	DAIUSD     AssetPair = "DAIUSD"
+	DAIUSDT    AssetPair = "DAIUSDT"
+	DASHEUR    AssetPair = "DASHEUR"
+	DASHUSD    AssetPair = "DASHUSD"
+	DASHXBT    AssetPair = "DASHXBT"
+	DENTEUR    AssetPair = "DENTEUR"
+	DENTUSD    AssetPair = "DENTUSD"
+	DOTETH     AssetPair = "DOTETH"
+	DOTEUR     AssetPair = "DOTEUR"
+	DOTGBP     AssetPair = "DOTGBP"
+	DOTJPY     AssetPair = "DOTJPY"
+	DOTUSD     AssetPair = "DOTUSD"
+	DOTUSDT    AssetPair = "DOTUSDT"
+	DOTXBT     AssetPair = "DOTXBT"
+	DYDXEUR    AssetPair = "DYDXEUR"
+	DYDXUSD    AssetPair = "DYDXUSD"
+	EGLDEUR    AssetPair = "EGLDEUR"
+	EGLDUSD    AssetPair = "EGLDUSD"
+	ENJEUR     AssetPair = "ENJEUR"
+	ENJGBP     AssetPair = "ENJGBP"
+	ENJJPY     AssetPair = "ENJJPY"
+	ENJUSD     AssetPair = "ENJUSD"
+	ENJXBT     AssetPair = "ENJXBT"
+	ENSEUR     AssetPair = "ENSEUR"
+	ENSUSD     AssetPair = "ENSUSD"
+	EOSETH     AssetPair = "EOSETH"
+	EOSEUR     AssetPair = "EOSEUR"
+	EOSUSD     AssetPair = "EOSUSD"
+	EOSUSDT    AssetPair = "EOSUSDT"
+	EOSXBT     AssetPair = "EOSXBT"
+	ETH2SETH   AssetPair = "ETH2.SETH"
+	ETHAED     AssetPair = "ETHAED"
+	ETHAUD     AssetPair = "ETHAUD"
+	ETHCHF     AssetPair = "ETHCHF"
+	ETHDAI     AssetPair = "ETHDAI"
+	ETHUSDC    AssetPair = "ETHUSDC"
+	ETHUSDT    AssetPair = "ETHUSDT"
+	ETHWETH    AssetPair = "ETHWETH"
+	ETHWEUR    AssetPair = "ETHWEUR"
+	ETHWUSD    AssetPair = "ETHWUSD"
+	EULEUR     AssetPair = "EULEUR"
+	EULUSD     AssetPair = "EULUSD"
+	EURAUD     AssetPair = "EURAUD"
+	EURCAD     AssetPair = "EURCAD"
+	EURCHF     AssetPair = "EURCHF"
+	EURGBP     AssetPair = "EURGBP"
+	EURJPY     AssetPair = "EURJPY"
+	EURTEUR    AssetPair = "EURTEUR"
+	EURTUSD    AssetPair = "EURTUSD"
+	EURTUSDT   AssetPair = "EURTUSDT"
+	EWTEUR     AssetPair = "EWTEUR"
+	EWTGBP     AssetPair = "EWTGBP"
+	EWTUSD     AssetPair = "EWTUSD"
+	EWTXBT     AssetPair = "EWTXBT"
+	FARMEUR    AssetPair = "FARMEUR"
+	FARMUSD    AssetPair = "FARMUSD"
+	FETEUR     AssetPair = "FETEUR"
+	FETUSD     AssetPair = "FETUSD"
+	FIDAEUR    AssetPair = "FIDAEUR"
+	FIDAUSD    AssetPair = "FIDAUSD"
+	FILETH     AssetPair = "FILETH"
+	FILEUR     AssetPair = "FILEUR"
+	FILGBP     AssetPair = "FILGBP"
+	FILUSD     AssetPair = "FILUSD"
+	FILXBT     AssetPair = "FILXBT"
+	FISEUR     AssetPair = "FISEUR"
+	FISUSD     AssetPair = "FISUSD"
+	FLOWETH    AssetPair = "FLOWETH"
+	FLOWEUR    AssetPair = "FLOWEUR"
+	FLOWGBP    AssetPair = "FLOWGBP"
+	FLOWUSD    AssetPair = "FLOWUSD"
+	FLOWXBT    AssetPair = "FLOWXBT"
+	FLREUR     AssetPair = "FLREUR"
+	FLRUSD     AssetPair = "FLRUSD"
+	FORTHEUR   AssetPair = "FORTHEUR"
+	FORTHUSD   AssetPair = "FORTHUSD"
+	FTMEUR     AssetPair = "FTMEUR"
+	FTMUSD     AssetPair = "FTMUSD"
+	FXSEUR     AssetPair = "FXSEUR"
+	FXSUSD     AssetPair = "FXSUSD"
+	GALAEUR    AssetPair = "GALAEUR"
+	GALAUSD    AssetPair = "GALAUSD"
+	GALEUR     AssetPair = "GALEUR"
+	GALUSD     AssetPair = "GALUSD"
+	GARIEUR    AssetPair = "GARIEUR"
+	GARIUSD    AssetPair = "GARIUSD"
+	GHSTEUR    AssetPair = "GHSTEUR"
+	GHSTUSD    AssetPair = "GHSTUSD"
+	GHSTXBT    AssetPair = "GHSTXBT"
+	GLMREUR    AssetPair = "GLMREUR"
+	GLMRUSD    AssetPair = "GLMRUSD"
+	GMTEUR     AssetPair = "GMTEUR"
+	GMTUSD     AssetPair = "GMTUSD"
+	GMXEUR     AssetPair = "GMXEUR"
+	GMXUSD     AssetPair = "GMXUSD"
+	GNOEUR     AssetPair = "GNOEUR"
+	GNOUSD     AssetPair = "GNOUSD"
+	GNOXBT     AssetPair = "GNOXBT"
+	GRTEUR     AssetPair = "GRTEUR"
+	GRTGBP     AssetPair = "GRTGBP"
+	GRTUSD     AssetPair = "GRTUSD"
+	GRTXBT     AssetPair = "GRTXBT"
+	GSTEUR     AssetPair = "GSTEUR"
+	GSTUSD     AssetPair = "GSTUSD"
+	GTCEUR     AssetPair = "GTCEUR"
+	GTCUSD     AssetPair = "GTCUSD"
+	HDXEUR     AssetPair = "HDXEUR"
+	HDXUSD     AssetPair = "HDXUSD"
+	HFTEUR     AssetPair = "HFTEUR"
+	HFTUSD     AssetPair = "HFTUSD"
+	ICPEUR     AssetPair = "ICPEUR"
+	ICPUSD     AssetPair = "ICPUSD"
+	ICXETH     AssetPair = "ICXETH"
+	ICXEUR     AssetPair = "ICXEUR"
+	ICXUSD     AssetPair = "ICXUSD"
+	ICXXBT     AssetPair = "ICXXBT"
+	IDEXEUR    AssetPair = "IDEXEUR"
+	IDEXUSD    AssetPair = "IDEXUSD"
+	IMXEUR     AssetPair = "IMXEUR"
+	IMXUSD     AssetPair = "IMXUSD"
+	INJEUR     AssetPair = "INJEUR"
+	INJUSD     AssetPair = "INJUSD"
+	INTREUR    AssetPair = "INTREUR"
+	INTRUSD    AssetPair = "INTRUSD"
+	JASMYEUR   AssetPair = "JASMYEUR"
+	JASMYUSD   AssetPair = "JASMYUSD"
+	JUNOEUR    AssetPair = "JUNOEUR"
+	JUNOUSD    AssetPair = "JUNOUSD"
+	KAREUR     AssetPair = "KAREUR"
+	KARUSD     AssetPair = "KARUSD"
+	KAVAETH    AssetPair = "KAVAETH"
+	KAVAEUR    AssetPair = "KAVAEUR"
+	KAVAUSD    AssetPair = "KAVAUSD"
+	KAVAXBT    AssetPair = "KAVAXBT"
+	KEEPEUR    AssetPair = "KEEPEUR"
+	KEEPUSD    AssetPair = "KEEPUSD"
+	KEEPXBT    AssetPair = "KEEPXBT"
+	KEYEUR     AssetPair = "KEYEUR"
+	KEYUSD     AssetPair = "KEYUSD"
+	KILTEUR    AssetPair = "KILTEUR"
+	KILTUSD    AssetPair = "KILTUSD"
+	KINEUR     AssetPair = "KINEUR"
+	KINTEUR    AssetPair = "KINTEUR"
+	KINTUSD    AssetPair = "KINTUSD"
+	KINUSD     AssetPair = "KINUSD"
+	KNCETH     AssetPair = "KNCETH"
+	KNCEUR     AssetPair = "KNCEUR"
+	KNCUSD     AssetPair = "KNCUSD"
+	KNCXBT     AssetPair = "KNCXBT"
+	KP3REUR    AssetPair = "KP3REUR"
+	KP3RUSD    AssetPair = "KP3RUSD"
+	KSMDOT     AssetPair = "KSMDOT"
+	KSMETH     AssetPair = "KSMETH"
+	KSMEUR     AssetPair = "KSMEUR"
+	KSMGBP     AssetPair = "KSMGBP"
+	KSMUSD     AssetPair = "KSMUSD"
+	KSMXBT     AssetPair = "KSMXBT"
+	LCXEUR     AssetPair = "LCXEUR"
+	LCXUSD     AssetPair = "LCXUSD"
+	LDOEUR     AssetPair = "LDOEUR"
+	LDOUSD     AssetPair = "LDOUSD"
+	LINKAUD    AssetPair = "LINKAUD"
+	LINKETH    AssetPair = "LINKETH"
+	LINKEUR    AssetPair = "LINKEUR"
+	LINKGBP    AssetPair = "LINKGBP"
+	LINKJPY    AssetPair = "LINKJPY"
+	LINKUSD    AssetPair = "LINKUSD"
+	LINKUSDT   AssetPair = "LINKUSDT"
+	LINKXBT    AssetPair = "LINKXBT"
+	LMWREUR    AssetPair = "LMWREUR"
+	LMWRUSD    AssetPair = "LMWRUSD"
+	LPTEUR     AssetPair = "LPTEUR"
+	LPTGBP     AssetPair = "LPTGBP"
+	LPTUSD     AssetPair = "LPTUSD"
+	LPTXBT     AssetPair = "LPTXBT"
+	LRCEUR     AssetPair = "LRCEUR"
+	LRCUSD     AssetPair = "LRCUSD"
+	LSKETH     AssetPair = "LSKETH"
+	LSKEUR     AssetPair = "LSKEUR"
+	LSKUSD     AssetPair = "LSKUSD"
+	LSKXBT     AssetPair = "LSKXBT"
+	LTCAUD     AssetPair = "LTCAUD"
+	LTCETH     AssetPair = "LTCETH"
+	LTCGBP     AssetPair = "LTCGBP"
+	LTCUSDT    AssetPair = "LTCUSDT"
+	LUNA2EUR   AssetPair = "LUNA2EUR"
+	LUNA2USD   AssetPair = "LUNA2USD"
+	LUNAEUR    AssetPair = "LUNAEUR"
+	LUNAUSD    AssetPair = "LUNAUSD"
+	MANAETH    AssetPair = "MANAETH"
+	MANAEUR    AssetPair = "MANAEUR"
+	MANAUSD    AssetPair = "MANAUSD"
+	MANAUSDT   AssetPair = "MANAUSDT"
+	MANAXBT    AssetPair = "MANAXBT"
+	MASKEUR    AssetPair = "MASKEUR"
+	MASKUSD    AssetPair = "MASKUSD"
+	MATICEUR   AssetPair = "MATICEUR"
+	MATICGBP   AssetPair = "MATICGBP"
+	MATICUSD   AssetPair = "MATICUSD"
+	MATICUSDT  AssetPair = "MATICUSDT"
+	MATICXBT   AssetPair = "MATICXBT"
+	MCEUR      AssetPair = "MCEUR"
+	MCUSD      AssetPair = "MCUSD"
+	MINAEUR    AssetPair = "MINAEUR"
+	MINAGBP    AssetPair = "MINAGBP"
+	MINAUSD    AssetPair = "MINAUSD"
+	MINAXBT    AssetPair = "MINAXBT"
+	MIREUR     AssetPair = "MIREUR"
+	MIRUSD     AssetPair = "MIRUSD"
+	MKREUR     AssetPair = "MKREUR"
+	MKRUSD     AssetPair = "MKRUSD"
+	MKRXBT     AssetPair = "MKRXBT"
+	MNGOEUR    AssetPair = "MNGOEUR"
+	MNGOUSD    AssetPair = "MNGOUSD"
+	MOONEUR    AssetPair = "MOONEUR"
+	MOONUSD    AssetPair = "MOONUSD"
+	MOVREUR    AssetPair = "MOVREUR"
+	MOVRUSD    AssetPair = "MOVRUSD"
+	MSOLEUR    AssetPair = "MSOLEUR"
+	MSOLUSD    AssetPair = "MSOLUSD"
+	MULTIEUR   AssetPair = "MULTIEUR"
+	MULTIUSD   AssetPair = "MULTIUSD"
+	MVEUR      AssetPair = "MVEUR"
+	MVUSD      AssetPair = "MVUSD"
+	MXCEUR     AssetPair = "MXCEUR"
+	MXCUSD     AssetPair = "MXCUSD"
+	NANOETH    AssetPair = "NANOETH"
+	NANOEUR    AssetPair = "NANOEUR"
+	NANOUSD    AssetPair = "NANOUSD"
+	NANOXBT    AssetPair = "NANOXBT"
+	NEAREUR    AssetPair = "NEAREUR"
+	NEARUSD    AssetPair = "NEARUSD"
+	NMREUR     AssetPair = "NMREUR"
+	NMRUSD     AssetPair = "NMRUSD"
+	NODLEUR    AssetPair = "NODLEUR"
+	NODLUSD    AssetPair = "NODLUSD"
+	NYMEUR     AssetPair = "NYMEUR"
+	NYMUSD     AssetPair = "NYMUSD"
+	NYMXBT     AssetPair = "NYMXBT"
+	OCEANEUR   AssetPair = "OCEANEUR"
+	OCEANGBP   AssetPair = "OCEANGBP"
+	OCEANUSD   AssetPair = "OCEANUSD"
+	OCEANXBT   AssetPair = "OCEANXBT"
+	OGNEUR     AssetPair = "OGNEUR"
+	OGNUSD     AssetPair = "OGNUSD"
+	OMGETH     AssetPair = "OMGETH"
+	OMGEUR     AssetPair = "OMGEUR"
+	OMGJPY     AssetPair = "OMGJPY"
+	OMGUSD     AssetPair = "OMGUSD"
+	OMGXBT     AssetPair = "OMGXBT"
+	ORCAEUR    AssetPair = "ORCAEUR"
+	ORCAUSD    AssetPair = "ORCAUSD"
+	OXTEUR     AssetPair = "OXTEUR"
+	OXTUSD     AssetPair = "OXTUSD"
+	OXTXBT     AssetPair = "OXTXBT"
+	OXYEUR     AssetPair = "OXYEUR"
+	OXYUSD     AssetPair = "OXYUSD"
+	PARAEUR    AssetPair = "PARAEUR"
+	PARAUSD    AssetPair = "PARAUSD"
+	PAXGETH    AssetPair = "PAXGETH"
+	PAXGEUR    AssetPair = "PAXGEUR"
+	PAXGUSD    AssetPair = "PAXGUSD"
+	PAXGXBT    AssetPair = "PAXGXBT"
+	PEPEEUR    AssetPair = "PEPEEUR"
+	PEPEUSD    AssetPair = "PEPEUSD"
+	PERPEUR    AssetPair = "PERPEUR"
+	PERPUSD    AssetPair = "PERPUSD"
+	PHAEUR     AssetPair = "PHAEUR"
+	PHAUSD     AssetPair = "PHAUSD"
+	PLAEUR     AssetPair = "PLAEUR"
+	PLAUSD     AssetPair = "PLAUSD"
+	POLISEUR   AssetPair = "POLISEUR"
+	POLISUSD   AssetPair = "POLISUSD"
+	POLSEUR    AssetPair = "POLSEUR"
+	POLSUSD    AssetPair = "POLSUSD"
+	PONDEUR    AssetPair = "PONDEUR"
+	PONDUSD    AssetPair = "PONDUSD"
+	POWREUR    AssetPair = "POWREUR"
+	POWRUSD    AssetPair = "POWRUSD"
+	PSTAKEEUR  AssetPair = "PSTAKEEUR"
+	PSTAKEUSD  AssetPair = "PSTAKEUSD"
+	PYUSDEUR   AssetPair = "PYUSDEUR"
+	PYUSDUSD   AssetPair = "PYUSDUSD"
+	QNTEUR     AssetPair = "QNTEUR"
+	QNTUSD     AssetPair = "QNTUSD"
+	QTUMETH    AssetPair = "QTUMETH"
+	QTUMEUR    AssetPair = "QTUMEUR"
+	QTUMUSD    AssetPair = "QTUMUSD"
+	QTUMXBT    AssetPair = "QTUMXBT"
+	RADEUR     AssetPair = "RADEUR"
+	RADUSD     AssetPair = "RADUSD"
+	RAREEUR    AssetPair = "RAREEUR"
+	RAREUSD    AssetPair = "RAREUSD"
+	RARIEUR    AssetPair = "RARIEUR"
+	RARIUSD    AssetPair = "RARIUSD"
+	RARIXBT    AssetPair = "RARIXBT"
+	RAYEUR     AssetPair = "RAYEUR"
+	RAYUSD     AssetPair = "RAYUSD"
+	RBCEUR     AssetPair = "RBCEUR"
+	RBCUSD     AssetPair = "RBCUSD"
+	RENEUR     AssetPair = "RENEUR"
+	RENUSD     AssetPair = "RENUSD"
+	RENXBT     AssetPair = "RENXBT"
+	REPV2ETH   AssetPair = "REPV2ETH"
+	REPV2EUR   AssetPair = "REPV2EUR"
+	REPV2USD   AssetPair = "REPV2USD"
+	REPV2XBT   AssetPair = "REPV2XBT"
+	REQEUR     AssetPair = "REQEUR"
+	REQUSD     AssetPair = "REQUSD"
+	RLCEUR     AssetPair = "RLCEUR"
+	RLCUSD     AssetPair = "RLCUSD"
+	RNDREUR    AssetPair = "RNDREUR"
+	RNDRUSD    AssetPair = "RNDRUSD"
+	ROOKEUR    AssetPair = "ROOKEUR"
+	ROOKUSD    AssetPair = "ROOKUSD"
+	RPLEUR     AssetPair = "RPLEUR"
+	RPLUSD     AssetPair = "RPLUSD"
+	RUNEEUR    AssetPair = "RUNEEUR"
+	RUNEUSD    AssetPair = "RUNEUSD"
+	SAMOEUR    AssetPair = "SAMOEUR"
+	SAMOUSD    AssetPair = "SAMOUSD"
+	SANDEUR    AssetPair = "SANDEUR"
+	SANDGBP    AssetPair = "SANDGBP"
+	SANDUSD    AssetPair = "SANDUSD"
+	SANDXBT    AssetPair = "SANDXBT"
+	SBREUR     AssetPair = "SBREUR"
+	SBRUSD     AssetPair = "SBRUSD"
+	SCETH      AssetPair = "SCETH"
+	SCEUR      AssetPair = "SCEUR"
+	SCRTEUR    AssetPair = "SCRTEUR"
+	SCRTUSD    AssetPair = "SCRTUSD"
+	SCUSD      AssetPair = "SCUSD"
+	SCXBT      AssetPair = "SCXBT"
+	SDNEUR     AssetPair = "SDNEUR"
+	SDNUSD     AssetPair = "SDNUSD"
+	SEIEUR     AssetPair = "SEIEUR"
+	SEIUSD     AssetPair = "SEIUSD"
+	SGBEUR     AssetPair = "SGBEUR"
+	SGBUSD     AssetPair = "SGBUSD"
+	SHIBEUR    AssetPair = "SHIBEUR"
+	SHIBUSD    AssetPair = "SHIBUSD"
+	SHIBUSDT   AssetPair = "SHIBUSDT"
+	SNXETH     AssetPair = "SNXETH"
+	SNXEUR     AssetPair = "SNXEUR"
+	SNXUSD     AssetPair = "SNXUSD"
+	SNXXBT     AssetPair = "SNXXBT"
+	SOLEUR     AssetPair = "SOLEUR"
+	SOLGBP     AssetPair = "SOLGBP"
+	SOLUSD     AssetPair = "SOLUSD"
+	SOLUSDT    AssetPair = "SOLUSDT"
+	SOLXBT     AssetPair = "SOLXBT"
+	SPELLEUR   AssetPair = "SPELLEUR"
+	SPELLUSD   AssetPair = "SPELLUSD"
+	SRMEUR     AssetPair = "SRMEUR"
+	SRMUSD     AssetPair = "SRMUSD"
+	SRMXBT     AssetPair = "SRMXBT"
+	STEPEUR    AssetPair = "STEPEUR"
+	STEPUSD    AssetPair = "STEPUSD"
+	STGEUR     AssetPair = "STGEUR"
+	STGUSD     AssetPair = "STGUSD"
+	STORJEUR   AssetPair = "STORJEUR"
+	STORJUSD   AssetPair = "STORJUSD"
+	STORJXBT   AssetPair = "STORJXBT"
+	STXEUR     AssetPair = "STXEUR"
+	STXUSD     AssetPair = "STXUSD"
+	SUIEUR     AssetPair = "SUIEUR"
+	SUIUSD     AssetPair = "SUIUSD"
+	SUPEREUR   AssetPair = "SUPEREUR"
+	SUPERUSD   AssetPair = "SUPERUSD"
+	SUSHIEUR   AssetPair = "SUSHIEUR"
+	SUSHIGBP   AssetPair = "SUSHIGBP"
+	SUSHIUSD   AssetPair = "SUSHIUSD"
+	SUSHIXBT   AssetPair = "SUSHIXBT"
+	SYNEUR     AssetPair = "SYNEUR"
+	SYNUSD     AssetPair = "SYNUSD"
+	TBTCEUR    AssetPair = "TBTCEUR"
+	TBTCUSD    AssetPair = "TBTCUSD"
+	TBTCXBT    AssetPair = "TBTCXBT"
+	TEEREUR    AssetPair = "TEEREUR"
+	TEERUSD    AssetPair = "TEERUSD"
+	TEUR       AssetPair = "TEUR"
+	TLMEUR     AssetPair = "TLMEUR"
+	TLMUSD     AssetPair = "TLMUSD"
+	TOKEEUR    AssetPair = "TOKEEUR"
+	TOKEUSD    AssetPair = "TOKEUSD"
+	TRUEUR     AssetPair = "TRUEUR"
+	TRUUSD     AssetPair = "TRUUSD"
+	TRXETH     AssetPair = "TRXETH"
+	TRXEUR     AssetPair = "TRXEUR"
+	TRXUSD     AssetPair = "TRXUSD"
+	TRXXBT     AssetPair = "TRXXBT"
+	TUSDPair   AssetPair = "TUSD"
+	TUSDEUR    AssetPair = "TUSDEUR"
+	TUSDUSD    AssetPair = "TUSDUSD"
+	TVKEUR     AssetPair = "TVKEUR"
+	TVKUSD     AssetPair = "TVKUSD"
+	UMAEUR     AssetPair = "UMAEUR"
+	UMAUSD     AssetPair = "UMAUSD"
+	UNFIEUR    AssetPair = "UNFIEUR"
+	UNFIUSD    AssetPair = "UNFIUSD"
+	UNIETH     AssetPair = "UNIETH"
+	UNIEUR     AssetPair = "UNIEUR"
+	UNIUSD     AssetPair = "UNIUSD"
+	UNIXBT     AssetPair = "UNIXBT"
+	USDAED     AssetPair = "USDAED"
+	USDCAUD    AssetPair = "USDCAUD"
+	USDCCAD    AssetPair = "USDCCAD"
+	USDCCHF    AssetPair = "USDCCHF"
+	USDCEUR    AssetPair = "USDCEUR"
+	USDCGBP    AssetPair = "USDCGBP"
+	USDCHF     AssetPair = "USDCHF"
+	USDCUSD    AssetPair = "USDCUSD"
+	USDCUSDT   AssetPair = "USDCUSDT"
+	USDTAUD    AssetPair = "USDTAUD"
+	USDTCAD    AssetPair = "USDTCAD"
+	USDTCHF    AssetPair = "USDTCHF"
+	USDTEUR    AssetPair = "USDTEUR"
+	USDTGBP    AssetPair = "USDTGBP"
+	USDTJPY    AssetPair = "USDTJPY"
+	USDTZUSD   AssetPair = "USDTZUSD"
+	USTEUR     AssetPair = "USTEUR"
+	USTUSD     AssetPair = "USTUSD"
+	USTUSDC    AssetPair = "USTUSDC"
+	USTUSDT    AssetPair = "USTUSDT"
+	WAVESETH   AssetPair = "WAVESETH"
+	WAVESEUR   AssetPair = "WAVESEUR"
+	WAVESUSD   AssetPair = "WAVESUSD"
+	WAVESXBT   AssetPair = "WAVESXBT"
+	WAXLEUR    AssetPair = "WAXLEUR"
+	WAXLUSD    AssetPair = "WAXLUSD"
+	WBTCEUR    AssetPair = "WBTCEUR"
+	WBTCUSD    AssetPair = "WBTCUSD"
+	WBTCXBT    AssetPair = "WBTCXBT"
+	WOOEUR     AssetPair = "WOOEUR"
+	WOOUSD     AssetPair = "WOOUSD"
+	XBTAED     AssetPair = "XBTAED"
+	XBTAUD     AssetPair = "XBTAUD"
+	XBTCHF     AssetPair = "XBTCHF"
+	XBTDAI     AssetPair = "XBTDAI"
+	XBTUSDC    AssetPair = "XBTUSDC"
+	XBTUSDT    AssetPair = "XBTUSDT"
+	XCNEUR     AssetPair = "XCNEUR"
+	XCNUSD     AssetPair = "XCNUSD"
+	XDGEUR     AssetPair = "XDGEUR"
+	XDGUSD     AssetPair = "XDGUSD"
+	XDGUSDT    AssetPair = "XDGUSDT"
+	XETCXETH   AssetPair = "XETCXETH"
+	XETCXXBT   AssetPair = "XETCXXBT"
+	XETCZEUR   AssetPair = "XETCZEUR"
+	XETCZUSD   AssetPair = "XETCZUSD"
+	XETHXXBT   AssetPair = "XETHXXBT"
+	XETHZCAD   AssetPair = "XETHZCAD"
+	XETHZEUR   AssetPair = "XETHZEUR"
+	XETHZGBP   AssetPair = "XETHZGBP"
+	XETHZJPY   AssetPair = "XETHZJPY"
+	XETHZUSD   AssetPair = "XETHZUSD"
+	XLTCXXBT   AssetPair = "XLTCXXBT"
+	XLTCZEUR   AssetPair = "XLTCZEUR"
+	XLTCZJPY   AssetPair = "XLTCZJPY"
+	XLTCZUSD   AssetPair = "XLTCZUSD"
+	XMLNXETH   AssetPair = "XMLNXETH"
+	XMLNXXBT   AssetPair = "XMLNXXBT"
+	XMLNZEUR   AssetPair = "XMLNZEUR"
+	XMLNZUSD   AssetPair = "XMLNZUSD"
+	XMRUSDT    AssetPair = "XMRUSDT"
+	XREPXXBT   AssetPair = "XREPXXBT"
+	XREPZEUR   AssetPair = "XREPZEUR"
+	XREPZUSD   AssetPair = "XREPZUSD"
+	XRPAUD     AssetPair = "XRPAUD"
+	XRPETH     AssetPair = "XRPETH"
+	XRPGBP     AssetPair = "XRPGBP"
+	XRPUSDT    AssetPair = "XRPUSDT"
+	XRTEUR     AssetPair = "XRTEUR"
+	XRTUSD     AssetPair = "XRTUSD"
+	XTZAUD     AssetPair = "XTZAUD"
+	XTZETH     AssetPair = "XTZETH"
+	XTZEUR     AssetPair = "XTZEUR"
+	XTZGBP     AssetPair = "XTZGBP"
+	XTZUSD     AssetPair = "XTZUSD"
+	XTZUSDT    AssetPair = "XTZUSDT"
+	XTZXBT     AssetPair = "XTZXBT"
+	XXBTZCAD   AssetPair = "XXBTZCAD"
+	XXBTZEUR   AssetPair = "XXBTZEUR"
+	XXBTZGBP   AssetPair = "XXBTZGBP"
+	XXBTZJPY   AssetPair = "XXBTZJPY"
+	XXBTZUSD   AssetPair = "XXBTZUSD"
+	XXDGXXBT   AssetPair = "XXDGXXBT"
+	XXLMXXBT   AssetPair = "XXLMXXBT"
+	XXLMZEUR   AssetPair = "XXLMZEUR"
+	XXLMZGBP   AssetPair = "XXLMZGBP"
+	XXLMZUSD   AssetPair = "XXLMZUSD"
+	XXMRXXBT   AssetPair = "XXMRXXBT"
+	XXMRZEUR   AssetPair = "XXMRZEUR"
+	XXMRZUSD   AssetPair = "XXMRZUSD"
+	XXRPXXBT   AssetPair = "XXRPXXBT"
+	XXRPZCAD   AssetPair = "XXRPZCAD"
+	XXRPZEUR   AssetPair = "XXRPZEUR"
+	XXRPZJPY   AssetPair = "XXRPZJPY"
+	XXRPZUSD   AssetPair = "XXRPZUSD"
+	XZECXXBT   AssetPair = "XZECXXBT"
+	XZECZEUR   AssetPair = "XZECZEUR"
+	XZECZUSD   AssetPair = "XZECZUSD"
+	YFIEUR     AssetPair = "YFIEUR"
+	YFIUSD     AssetPair = "YFIUSD"
+	YFIXBT     AssetPair = "YFIXBT"
+	YGGEUR     AssetPair = "YGGEUR"
+	YGGUSD     AssetPair = "YGGUSD"
+	ZEURZUSD   AssetPair = "ZEURZUSD"
+	ZGBPZUSD   AssetPair = "ZGBPZUSD"
+	ZRXEUR     AssetPair = "ZRXEUR"
+	ZRXUSD     AssetPair = "ZRXUSD"
+	ZRXXBT     AssetPair = "ZRXXBT"
+	ZUSDZCAD   AssetPair = "ZUSDZCAD"
+	ZUSDZJPY   AssetPair = "ZUSDZJPY"
+)
+
+// KnownAssets holds the metadata of the assets available on Kraken when the constants were generated.
+// Use MarketData.Assets for up to date information.
+var KnownAssets = Assets{
+	ONEINCH:  {Altname: "1INCH", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	AAVE:     {Altname: "AAVE", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ACA:      {Altname: "ACA", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ACH:      {Altname: "ACH", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ADA:      {Altname: "ADA", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	ADAS:     {Altname: "ADA.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	ADX:      {Altname: "ADX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	AED:      {Altname: "AED", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	AEDHOLD:  {Altname: "AED.HOLD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	AGLD:     {Altname: "AGLD", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	AIR:      {Altname: "AIR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	AKT:      {Altname: "AKT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ALCX:     {Altname: "ALCX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ALGO:     {Altname: "ALGO", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	ALGOS:    {Altname: "ALGO.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	ALICE:    {Altname: "ALICE", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ALPHA:    {Altname: "ALPHA", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	ANKR:     {Altname: "ANKR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ANT:      {Altname: "ANT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	APE:      {Altname: "APE", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	API3:     {Altname: "API3", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	APT:      {Altname: "APT", AssetClass: "currency", Decimals: 6, DisplayDecimals: 4, Status: "enabled"},
+	ARB:      {Altname: "ARB", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	ARPA:     {Altname: "ARPA", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	ASTR:     {Altname: "ASTR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ATLAS:    {Altname: "ATLAS", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	ATOM:     {Altname: "ATOM", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	ATOMS:    {Altname: "ATOM.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	ATOM21S:  {Altname: "ATOM21.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	AUDHOLD:  {Altname: "AUD.HOLD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	AUDIO:    {Altname: "AUDIO", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	AVAX:     {Altname: "AVAX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	AVT:      {Altname: "AVT", AssetClass: "currency", Decimals: 6, DisplayDecimals: 4, Status: "enabled"},
+	AXS:      {Altname: "AXS", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BADGER:   {Altname: "BADGER", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BAL:      {Altname: "BAL", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BAND:     {Altname: "BAND", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BAT:      {Altname: "BAT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BCH:      {Altname: "BCH", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BICO:     {Altname: "BICO", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BIT:      {Altname: "BIT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BLUR:     {Altname: "BLUR", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	BLZ:      {Altname: "BLZ", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	BNC:      {Altname: "BNC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BNT:      {Altname: "BNT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BOBA:     {Altname: "BOBA", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	BOND:     {Altname: "BOND", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	BRICK:    {Altname: "BRICK", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	BSX:      {Altname: "BSX", AssetClass: "currency", Decimals: 2, DisplayDecimals: 2, Status: "enabled"},
+	BTT:      {Altname: "BTT", AssetClass: "currency", Decimals: 1, DisplayDecimals: 1, Status: "enabled"},
+	C98:      {Altname: "C98", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	CADHOLD:  {Altname: "CAD.HOLD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	CELR:     {Altname: "CELR", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	CFG:      {Altname: "CFG", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	CHF:      {Altname: "CHF", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	CHFHOLD:  {Altname: "CHF.HOLD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	CHR:      {Altname: "CHR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	CHZ:      {Altname: "CHZ", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	COMP:     {Altname: "COMP", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	COTI:     {Altname: "COTI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	CQT:      {Altname: "CQT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	CRV:      {Altname: "CRV", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	CSM:      {Altname: "CSM", AssetClass: "currency", Decimals: 6, DisplayDecimals: 3, Status: "enabled"},
+	CTSI:     {Altname: "CTSI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	CVC:      {Altname: "CVC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	CVX:      {Altname: "CVX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	DAI:      {Altname: "DAI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	DASH:     {Altname: "DASH", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	DENT:     {Altname: "DENT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	DOT:      {Altname: "DOT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	DOTP:     {Altname: "DOT.P", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	DOTS:     {Altname: "DOT.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	DOT28S:   {Altname: "DOT28.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	DYDX:     {Altname: "DYDX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	EGLD:     {Altname: "EGLD", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	ENJ:      {Altname: "ENJ", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ENS:      {Altname: "ENS", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	EOS:      {Altname: "EOS", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ETH2:     {Altname: "ETH2", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ETH2S:    {Altname: "ETH2.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ETHW:     {Altname: "ETHW", AssetClass: "currency", Decimals: 7, DisplayDecimals: 5, Status: "enabled"},
+	EUL:      {Altname: "EUL", AssetClass: "currency", Decimals: 6, DisplayDecimals: 4, Status: "enabled"},
+	EURHOLD:  {Altname: "EUR.HOLD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	EURM:     {Altname: "EUR.M", AssetClass: "currency", Decimals: 4, DisplayDecimals: 4, Status: "enabled"},
+	EURT:     {Altname: "EURT", AssetClass: "currency", Decimals: 8, DisplayDecimals: 4, Status: "enabled"},
+	EWT:      {Altname: "EWT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	FARM:     {Altname: "FARM", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	FET:      {Altname: "FET", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	FIDA:     {Altname: "FIDA", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	FIL:      {Altname: "FIL", AssetClass: "currency", Decimals: 10, DisplayDecimals: 6, Status: "enabled"},
+	FIS:      {Altname: "FIS", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	FLOW:     {Altname: "FLOW", AssetClass: "currency", Decimals: 10, DisplayDecimals: 6, Status: "enabled"},
+	FLOWS:    {Altname: "FLOW.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 6, Status: "enabled"},
+	FLOW14S:  {Altname: "FLOW14.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 6, Status: "enabled"},
+	FLOWH:    {Altname: "FLOWH", AssetClass: "currency", Decimals: 10, DisplayDecimals: 6, Status: "enabled"},
+	FLOWHS:   {Altname: "FLOWH.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 6, Status: "enabled"},
+	FLR:      {Altname: "FLR", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	FLRS:     {Altname: "FLR.S", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	FORTH:    {Altname: "FORTH", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	FTM:      {Altname: "FTM", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	FXS:      {Altname: "FXS", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GAL:      {Altname: "GAL", AssetClass: "currency", Decimals: 6, DisplayDecimals: 4, Status: "enabled"},
+	GALA:     {Altname: "GALA", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GARI:     {Altname: "GARI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GBPHOLD:  {Altname: "GBP.HOLD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	GHST:     {Altname: "GHST", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GLMR:     {Altname: "GLMR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GMT:      {Altname: "GMT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GMX:      {Altname: "GMX", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	GNO:      {Altname: "GNO", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GRT:      {Altname: "GRT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GRTS:     {Altname: "GRT.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GRT28S:   {Altname: "GRT28.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GST:      {Altname: "GST", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	GTC:      {Altname: "GTC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	HDX:      {Altname: "HDX", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	HFT:      {Altname: "HFT", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	ICP:      {Altname: "ICP", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	ICX:      {Altname: "ICX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	IDEX:     {Altname: "IDEX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	IMX:      {Altname: "IMX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	INJ:      {Altname: "INJ", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	INTR:     {Altname: "INTR", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	JASMY:    {Altname: "JASMY", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	JUNO:     {Altname: "JUNO", AssetClass: "currency", Decimals: 6, DisplayDecimals: 5, Status: "enabled"},
+	KAR:      {Altname: "KAR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	KAVA:     {Altname: "KAVA", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	KAVAS:    {Altname: "KAVA.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	KAVA21S:  {Altname: "KAVA21.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	KEEP:     {Altname: "KEEP", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	KEY:      {Altname: "KEY", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	KFEE:     {Altname: "FEE", AssetClass: "currency", Decimals: 2, DisplayDecimals: 2, Status: "enabled"},
+	KILT:     {Altname: "KILT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	KIN:      {Altname: "KIN", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	KINT:     {Altname: "KINT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	KNC:      {Altname: "KNC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	KP3R:     {Altname: "KP3R", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	KSM:      {Altname: "KSM", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	KSMP:     {Altname: "KSM.P", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	KSMS:     {Altname: "KSM.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	KSM07S:   {Altname: "KSM07.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	LCX:      {Altname: "LCX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	LDO:      {Altname: "LDO", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	LINK:     {Altname: "LINK", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	LMWR:     {Altname: "LMWR", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	LPT:      {Altname: "LPT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	LRC:      {Altname: "LRC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	LSK:      {Altname: "LSK", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	LUNA:     {Altname: "LUNA", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	LUNAS:    {Altname: "LUNA.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	LUNA2:    {Altname: "LUNA2", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	MANA:     {Altname: "MANA", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MASK:     {Altname: "MASK", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MATIC:    {Altname: "MATIC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MATICS:   {Altname: "MATIC.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MATIC04S: {Altname: "MATIC04.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MC:       {Altname: "MC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MINA:     {Altname: "MINA", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MINAS:    {Altname: "MINA.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MIR:      {Altname: "MIR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MKR:      {Altname: "MKR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MNGO:     {Altname: "MNGO", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	MOON:     {Altname: "MOON", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	MOVR:     {Altname: "MOVR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MSOL:     {Altname: "MSOL", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MULTI:    {Altname: "MULTI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MV:       {Altname: "MV", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	MXC:      {Altname: "MXC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	NANO:     {Altname: "NANO", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	NEAR:     {Altname: "NEAR", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	NMR:      {Altname: "NMR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	NODL:     {Altname: "NODL", AssetClass: "currency", Decimals: 6, DisplayDecimals: 4, Status: "enabled"},
+	NYM:      {Altname: "NYM", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	OCEAN:    {Altname: "OCEAN", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	OGN:      {Altname: "OGN", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	OMG:      {Altname: "OMG", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ORCA:     {Altname: "ORCA", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	OXT:      {Altname: "OXT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	OXY:      {Altname: "OXY", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	PARA:     {Altname: "PARA", AssetClass: "currency", Decimals: 3, DisplayDecimals: 1, Status: "enabled"},
+	PAXG:     {Altname: "PAXG", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	PEPE:     {Altname: "PEPE", AssetClass: "currency", Decimals: 2, DisplayDecimals: 0, Status: "enabled"},
+	PERP:     {Altname: "PERP", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	PHA:      {Altname: "PHA", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	PLA:      {Altname: "PLA", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	POLIS:    {Altname: "POLIS", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	POLS:     {Altname: "POLS", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	POND:     {Altname: "POND", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	POWR:     {Altname: "POWR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	PSTAKE:   {Altname: "PSTAKE", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	PYUSD:    {Altname: "PYUSD", AssetClass: "currency", Decimals: 8, DisplayDecimals: 4, Status: "enabled"},
+	QNT:      {Altname: "QNT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	QTUM:     {Altname: "QTUM", AssetClass: "currency", Decimals: 10, DisplayDecimals: 6, Status: "enabled"},
+	RAD:      {Altname: "RAD", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	RARE:     {Altname: "RARE", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	RARI:     {Altname: "RARI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	RAY:      {Altname: "RAY", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	RBC:      {Altname: "RBC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	REN:      {Altname: "REN", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	REPV2:    {Altname: "REPV2", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	REQ:      {Altname: "REQ", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	RLC:      {Altname: "RLC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	RNDR:     {Altname: "RNDR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ROOK:     {Altname: "ROOK", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	RPL:      {Altname: "RPL", AssetClass: "currency", Decimals: 7, DisplayDecimals: 5, Status: "enabled"},
+	RUNE:     {Altname: "RUNE", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SAMO:     {Altname: "SAMO", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	SAND:     {Altname: "SAND", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SBR:      {Altname: "SBR", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	SC:       {Altname: "SC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SCRT:     {Altname: "SCRT", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	SCRTS:    {Altname: "SCRT.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	SCRT21S:  {Altname: "SCRT21.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	SDN:      {Altname: "SDN", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SEI:      {Altname: "SEI", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	SGB:      {Altname: "SGB", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SHIB:     {Altname: "SHIB", AssetClass: "currency", Decimals: 5, DisplayDecimals: 0, Status: "enabled"},
+	SNX:      {Altname: "SNX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SOL:      {Altname: "SOL", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SOLS:     {Altname: "SOL.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SOL03S:   {Altname: "SOL03.S", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SPELL:    {Altname: "SPELL", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SRM:      {Altname: "SRM", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	STEP:     {Altname: "STEP", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	STG:      {Altname: "STG", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	STORJ:    {Altname: "STORJ", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	STX:      {Altname: "STX", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	SUI:      {Altname: "SUI", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	SUPER:    {Altname: "SUPER", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SUSHI:    {Altname: "SUSHI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SXP:      {Altname: "SXP", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	SYN:      {Altname: "SYN", AssetClass: "currency", Decimals: 6, DisplayDecimals: 4, Status: "enabled"},
+	T:        {Altname: "T", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	TBTC:     {Altname: "TBTC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	TEER:     {Altname: "TEER", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	TLM:      {Altname: "TLM", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	TOKE:     {Altname: "TOKE", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	TRIBE:    {Altname: "TRIBE", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	TRU:      {Altname: "TRU", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	TRX:      {Altname: "TRX", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	TRXS:     {Altname: "TRX.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	TUSD:     {Altname: "TUSD", AssetClass: "currency", Decimals: 8, DisplayDecimals: 4, Status: "enabled"},
+	TVK:      {Altname: "TVK", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	UMA:      {Altname: "UMA", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	UNFI:     {Altname: "UNFI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	UNI:      {Altname: "UNI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	USDHOLD:  {Altname: "USD.HOLD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	USDM:     {Altname: "USD.M", AssetClass: "currency", Decimals: 4, DisplayDecimals: 4, Status: "enabled"},
+	USDC:     {Altname: "USDC", AssetClass: "currency", Decimals: 8, DisplayDecimals: 4, Status: "enabled"},
+	USDCM:    {Altname: "USDC.M", AssetClass: "currency", Decimals: 8, DisplayDecimals: 4, Status: "enabled"},
+	USDT:     {Altname: "USDT", AssetClass: "currency", Decimals: 8, DisplayDecimals: 4, Status: "enabled"},
+	USDTM:    {Altname: "USDT.M", AssetClass: "currency", Decimals: 8, DisplayDecimals: 4, Status: "enabled"},
+	UST:      {Altname: "UST", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	WAVES:    {Altname: "WAVES", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	WAXL:     {Altname: "WAXL", AssetClass: "currency", Decimals: 5, DisplayDecimals: 3, Status: "enabled"},
+	WBTC:     {Altname: "WBTC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	WETH:     {Altname: "WETH", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	WOO:      {Altname: "WOO", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XBTM:     {Altname: "XBT.M", AssetClass: "currency", Decimals: 10, DisplayDecimals: 8, Status: "enabled"},
+	XCN:      {Altname: "XCN", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	XETC:     {Altname: "ETC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XETH:     {Altname: "ETH", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XLTC:     {Altname: "LTC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XMLN:     {Altname: "MLN", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XREP:     {Altname: "REP", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XRT:      {Altname: "XRT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XTZ:      {Altname: "XTZ", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	XTZS:     {Altname: "XTZ.S", AssetClass: "currency", Decimals: 8, DisplayDecimals: 6, Status: "enabled"},
+	XXBT:     {Altname: "XBT", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XXDG:     {Altname: "XDG", AssetClass: "currency", Decimals: 8, DisplayDecimals: 2, Status: "enabled"},
+	XXLM:     {Altname: "XLM", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	XXMR:     {Altname: "XMR", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	XXRP:     {Altname: "XRP", AssetClass: "currency", Decimals: 8, DisplayDecimals: 5, Status: "enabled"},
+	XZEC:     {Altname: "ZEC", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	YFI:      {Altname: "YFI", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	YGG:      {Altname: "YGG", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ZAUD:     {Altname: "AUD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	ZCAD:     {Altname: "CAD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	ZEUR:     {Altname: "EUR", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	ZGBP:     {Altname: "GBP", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+	ZJPY:     {Altname: "JPY", AssetClass: "currency", Decimals: 2, DisplayDecimals: 0, Status: "enabled"},
+	ZRX:      {Altname: "ZRX", AssetClass: "currency", Decimals: 10, DisplayDecimals: 5, Status: "enabled"},
+	ZUSD:     {Altname: "USD", AssetClass: "currency", Decimals: 4, DisplayDecimals: 2, Status: "enabled"},
+}
+
+// KnownAssetPairs holds the metadata of the asset pairs available on Kraken when the constants were generated.
+// Fees and leverage are not included. Use MarketData.TradableAssetPairs for up to date information.
+var KnownAssetPairs = AssetPairs{
+	ONEINCHEUR: {Altname: "1INCHEUR", WSName: "1INCH/EUR", AClassBase: "currency", Base: "1INCH", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	ONEINCHUSD: {Altname: "1INCHUSD", WSName: "1INCH/USD", AClassBase: "currency", Base: "1INCH", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	AAVEETH:    {Altname: "AAVEETH", WSName: "AAVE/ETH", AClassBase: "currency", Base: "AAVE", AClassQuote: "currency", Quote: "XETH", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.07", CostMin: "0.0002", TickSize: "0.0001", Status: "online"},
+	AAVEEUR:    {Altname: "AAVEEUR", WSName: "AAVE/EUR", AClassBase: "currency", Base: "AAVE", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.07", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	AAVEGBP:    {Altname: "AAVEGBP", WSName: "AAVE/GBP", AClassBase: "currency", Base: "AAVE", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.07", CostMin: "0.43", TickSize: "0.01", Status: "online"},
+	AAVEUSD:    {Altname: "AAVEUSD", WSName: "AAVE/USD", AClassBase: "currency", Base: "AAVE", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.07", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	AAVEXBT:    {Altname: "AAVEXBT", WSName: "AAVE/XBT", AClassBase: "currency", Base: "AAVE", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.07", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	ACAEUR:     {Altname: "ACAEUR", WSName: "ACA/EUR", AClassBase: "currency", Base: "ACA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	ACAUSD:     {Altname: "ACAUSD", WSName: "ACA/USD", AClassBase: "currency", Base: "ACA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	ACHEUR:     {Altname: "ACHEUR", WSName: "ACH/EUR", AClassBase: "currency", Base: "ACH", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "325", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	ACHUSD:     {Altname: "ACHUSD", WSName: "ACH/USD", AClassBase: "currency", Base: "ACH", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "325", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ADAAUD:     {Altname: "ADAAUD", WSName: "ADA/AUD", AClassBase: "currency", Base: "ADA", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "1", TickSize: "0.00001", Status: "online"},
+	ADAETH:     {Altname: "ADAETH", WSName: "ADA/ETH", AClassBase: "currency", Base: "ADA", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.0002", TickSize: "0.0000001", Status: "online"},
+	ADAEUR:     {Altname: "ADAEUR", WSName: "ADA/EUR", AClassBase: "currency", Base: "ADA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	ADAGBP:     {Altname: "ADAGBP", WSName: "ADA/GBP", AClassBase: "currency", Base: "ADA", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.43", TickSize: "0.00001", Status: "online"},
+	ADAUSD:     {Altname: "ADAUSD", WSName: "ADA/USD", AClassBase: "currency", Base: "ADA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	ADAUSDT:    {Altname: "ADAUSDT", WSName: "ADA/USDT", AClassBase: "currency", Base: "ADA", AClassQuote: "currency", Quote: "USDT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	ADAXBT:     {Altname: "ADAXBT", WSName: "ADA/XBT", AClassBase: "currency", Base: "ADA", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	ADXEUR:     {Altname: "ADXEUR", WSName: "ADX/EUR", AClassBase: "currency", Base: "ADX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	ADXUSD:     {Altname: "ADXUSD", WSName: "ADX/USD", AClassBase: "currency", Base: "ADX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	AGLDEUR:    {Altname: "AGLDEUR", WSName: "AGLD/EUR", AClassBase: "currency", Base: "AGLD", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "11", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	AGLDUSD:    {Altname: "AGLDUSD", WSName: "AGLD/USD", AClassBase: "currency", Base: "AGLD", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "11", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	AIREUR:     {Altname: "AIREUR", WSName: "AIR/EUR", AClassBase: "currency", Base: "AIR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "500", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	AIRUSD:     {Altname: "AIRUSD", WSName: "AIR/USD", AClassBase: "currency", Base: "AIR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "500", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	AKTEUR:     {Altname: "AKTEUR", WSName: "AKT/EUR", AClassBase: "currency", Base: "AKT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	AKTUSD:     {Altname: "AKTUSD", WSName: "AKT/USD", AClassBase: "currency", Base: "AKT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ALCXEUR:    {Altname: "ALCXEUR", WSName: "ALCX/EUR", AClassBase: "currency", Base: "ALCX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.3", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	ALCXUSD:    {Altname: "ALCXUSD", WSName: "ALCX/USD", AClassBase: "currency", Base: "ALCX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.3", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	ALGOETH:    {Altname: "ALGOETH", WSName: "ALGO/ETH", AClassBase: "currency", Base: "ALGO", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.0002", TickSize: "0.0000001", Status: "online"},
+	ALGOEUR:    {Altname: "ALGOEUR", WSName: "ALGO/EUR", AClassBase: "currency", Base: "ALGO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	ALGOGBP:    {Altname: "ALGOGBP", WSName: "ALGO/GBP", AClassBase: "currency", Base: "ALGO", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.43", TickSize: "0.00001", Status: "online"},
+	ALGOUSD:    {Altname: "ALGOUSD", WSName: "ALGO/USD", AClassBase: "currency", Base: "ALGO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ALGOUSDT:   {Altname: "ALGOUSDT", WSName: "ALGO/USDT", AClassBase: "currency", Base: "ALGO", AClassQuote: "currency", Quote: "USDT", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ALGOXBT:    {Altname: "ALGOXBT", WSName: "ALGO/XBT", AClassBase: "currency", Base: "ALGO", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	ALICEEUR:   {Altname: "ALICEEUR", WSName: "ALICE/EUR", AClassBase: "currency", Base: "ALICE", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	ALICEUSD:   {Altname: "ALICEUSD", WSName: "ALICE/USD", AClassBase: "currency", Base: "ALICE", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	ALPHAEUR:   {Altname: "ALPHAEUR", WSName: "ALPHA/EUR", AClassBase: "currency", Base: "ALPHA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	ALPHAUSD:   {Altname: "ALPHAUSD", WSName: "ALPHA/USD", AClassBase: "currency", Base: "ALPHA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ANKREUR:    {Altname: "ANKREUR", WSName: "ANKR/EUR", AClassBase: "currency", Base: "ANKR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "200", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	ANKRUSD:    {Altname: "ANKRUSD", WSName: "ANKR/USD", AClassBase: "currency", Base: "ANKR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "200", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ANKRXBT:    {Altname: "ANKRXBT", WSName: "ANKR/XBT", AClassBase: "currency", Base: "ANKR", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 9, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "200", CostMin: "0.00002", TickSize: "0.000000001", Status: "online"},
+	ANTETH:     {Altname: "ANTETH", WSName: "ANT/ETH", AClassBase: "currency", Base: "ANT", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	ANTEUR:     {Altname: "ANTEUR", WSName: "ANT/EUR", AClassBase: "currency", Base: "ANT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	ANTUSD:     {Altname: "ANTUSD", WSName: "ANT/USD", AClassBase: "currency", Base: "ANT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ANTXBT:     {Altname: "ANTXBT", WSName: "ANT/XBT", AClassBase: "currency", Base: "ANT", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	APEEUR:     {Altname: "APEEUR", WSName: "APE/EUR", AClassBase: "currency", Base: "APE", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	APEUSD:     {Altname: "APEUSD", WSName: "APE/USD", AClassBase: "currency", Base: "APE", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	APEUSDT:    {Altname: "APEUSDT", WSName: "APE/USDT", AClassBase: "currency", Base: "APE", AClassQuote: "currency", Quote: "USDT", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	API3EUR:    {Altname: "API3EUR", WSName: "API3/EUR", AClassBase: "currency", Base: "API3", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	API3USD:    {Altname: "API3USD", WSName: "API3/USD", AClassBase: "currency", Base: "API3", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	APTEUR:     {Altname: "APTEUR", WSName: "APT/EUR", AClassBase: "currency", Base: "APT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.35", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	APTUSD:     {Altname: "APTUSD", WSName: "APT/USD", AClassBase: "currency", Base: "APT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.35", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ARBEUR:     {Altname: "ARBEUR", WSName: "ARB/EUR", AClassBase: "currency", Base: "ARB", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	ARBUSD:     {Altname: "ARBUSD", WSName: "ARB/USD", AClassBase: "currency", Base: "ARB", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ARPAEUR:    {Altname: "ARPAEUR", WSName: "ARPA/EUR", AClassBase: "currency", Base: "ARPA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "125", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	ARPAUSD:    {Altname: "ARPAUSD", WSName: "ARPA/USD", AClassBase: "currency", Base: "ARPA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "125", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ASTREUR:    {Altname: "ASTREUR", WSName: "ASTR/EUR", AClassBase: "currency", Base: "ASTR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	ASTRUSD:    {Altname: "ASTRUSD", WSName: "ASTR/USD", AClassBase: "currency", Base: "ASTR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ATLASEUR:   {Altname: "ATLASEUR", WSName: "ATLAS/EUR", AClassBase: "currency", Base: "ATLAS", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1350", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	ATLASUSD:   {Altname: "ATLASUSD", WSName: "ATLAS/USD", AClassBase: "currency", Base: "ATLAS", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1350", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ATOMETH:    {Altname: "ATOMETH", WSName: "ATOM/ETH", AClassBase: "currency", Base: "ATOM", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	ATOMEUR:    {Altname: "ATOMEUR", WSName: "ATOM/EUR", AClassBase: "currency", Base: "ATOM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	ATOMGBP:    {Altname: "ATOMGBP", WSName: "ATOM/GBP", AClassBase: "currency", Base: "ATOM", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.43", TickSize: "0.0001", Status: "online"},
+	ATOMUSD:    {Altname: "ATOMUSD", WSName: "ATOM/USD", AClassBase: "currency", Base: "ATOM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ATOMUSDT:   {Altname: "ATOMUSDT", WSName: "ATOM/USDT", AClassBase: "currency", Base: "ATOM", AClassQuote: "currency", Quote: "USDT", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ATOMXBT:    {Altname: "ATOMXBT", WSName: "ATOM/XBT", AClassBase: "currency", Base: "ATOM", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	AUDIOEUR:   {Altname: "AUDIOEUR", WSName: "AUDIO/EUR", AClassBase: "currency", Base: "AUDIO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	AUDIOUSD:   {Altname: "AUDIOUSD", WSName: "AUDIO/USD", AClassBase: "currency", Base: "AUDIO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	AUDJPY:     {Altname: "AUDJPY", WSName: "AUD/JPY", AClassBase: "currency", Base: "ZAUD", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 3, CostDecimals: 3, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "50", TickSize: "0.001", Status: "online"},
+	AUDUSD:     {Altname: "AUDUSD", WSName: "AUD/USD", AClassBase: "currency", Base: "ZAUD", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	AVAXEUR:    {Altname: "AVAXEUR", WSName: "AVAX/EUR", AClassBase: "currency", Base: "AVAX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.3", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	AVAXUSD:    {Altname: "AVAXUSD", WSName: "AVAX/USD", AClassBase: "currency", Base: "AVAX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.3", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	AVAXUSDT:   {Altname: "AVAXUSDT", WSName: "AVAX/USDT", AClassBase: "currency", Base: "AVAX", AClassQuote: "currency", Quote: "USDT", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.3", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	AXSEUR:     {Altname: "AXSEUR", WSName: "AXS/EUR", AClassBase: "currency", Base: "AXS", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.65", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	AXSUSD:     {Altname: "AXSUSD", WSName: "AXS/USD", AClassBase: "currency", Base: "AXS", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.65", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	BADGEREUR:  {Altname: "BADGEREUR", WSName: "BADGER/EUR", AClassBase: "currency", Base: "BADGER", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	BADGERUSD:  {Altname: "BADGERUSD", WSName: "BADGER/USD", AClassBase: "currency", Base: "BADGER", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	BALEUR:     {Altname: "BALEUR", WSName: "BAL/EUR", AClassBase: "currency", Base: "BAL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	BALUSD:     {Altname: "BALUSD", WSName: "BAL/USD", AClassBase: "currency", Base: "BAL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	BALXBT:     {Altname: "BALXBT", WSName: "BAL/XBT", AClassBase: "currency", Base: "BAL", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	BANDEUR:    {Altname: "BANDEUR", WSName: "BAND/EUR", AClassBase: "currency", Base: "BAND", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	BANDUSD:    {Altname: "BANDUSD", WSName: "BAND/USD", AClassBase: "currency", Base: "BAND", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	BATETH:     {Altname: "BATETH", WSName: "BAT/ETH", AClassBase: "currency", Base: "BAT", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.0002", TickSize: "0.0000001", Status: "online"},
+	BATEUR:     {Altname: "BATEUR", WSName: "BAT/EUR", AClassBase: "currency", Base: "BAT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	BATJPY:     {Altname: "BATJPY", WSName: "BAT/JPY", AClassBase: "currency", Base: "BAT", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "50", TickSize: "0.01", Status: "online"},
+	BATUSD:     {Altname: "BATUSD", WSName: "BAT/USD", AClassBase: "currency", Base: "BAT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	BATXBT:     {Altname: "BATXBT", WSName: "BAT/XBT", AClassBase: "currency", Base: "BAT", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	BCHAUD:     {Altname: "BCHAUD", WSName: "BCH/AUD", AClassBase: "currency", Base: "BCH", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "1", TickSize: "0.01", Status: "online"},
+	BCHETH:     {Altname: "BCHETH", WSName: "BCH/ETH", AClassBase: "currency", Base: "BCH", AClassQuote: "currency", Quote: "XETH", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.0002", TickSize: "0.0001", Status: "online"},
+	BCHEUR:     {Altname: "BCHEUR", WSName: "BCH/EUR", AClassBase: "currency", Base: "BCH", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	BCHGBP:     {Altname: "BCHGBP", WSName: "BCH/GBP", AClassBase: "currency", Base: "BCH", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.43", TickSize: "0.01", Status: "online"},
+	BCHJPY:     {Altname: "BCHJPY", WSName: "BCH/JPY", AClassBase: "currency", Base: "BCH", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 0, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "50", TickSize: "1", Status: "online"},
+	BCHUSD:     {Altname: "BCHUSD", WSName: "BCH/USD", AClassBase: "currency", Base: "BCH", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	BCHUSDT:    {Altname: "BCHUSDT", WSName: "BCH/USDT", AClassBase: "currency", Base: "BCH", AClassQuote: "currency", Quote: "USDT", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	BCHXBT:     {Altname: "BCHXBT", WSName: "BCH/XBT", AClassBase: "currency", Base: "BCH", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.00002", TickSize: "0.00001", Status: "online"},
+	BICOEUR:    {Altname: "BICOEUR", WSName: "BICO/EUR", AClassBase: "currency", Base: "BICO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "17.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	BICOUSD:    {Altname: "BICOUSD", WSName: "BICO/USD", AClassBase: "currency", Base: "BICO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "17.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	BITEUR:     {Altname: "BITEUR", WSName: "BIT/EUR", AClassBase: "currency", Base: "BIT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "9", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	BITUSD:     {Altname: "BITUSD", WSName: "BIT/USD", AClassBase: "currency", Base: "BIT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "9", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	BLUREUR:    {Altname: "BLUREUR", WSName: "BLUR/EUR", AClassBase: "currency", Base: "BLUR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "7.5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	BLURUSD:    {Altname: "BLURUSD", WSName: "BLUR/USD", AClassBase: "currency", Base: "BLUR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "7.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	BLZEUR:     {Altname: "BLZEUR", WSName: "BLZ/EUR", AClassBase: "currency", Base: "BLZ", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "55", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	BLZUSD:     {Altname: "BLZUSD", WSName: "BLZ/USD", AClassBase: "currency", Base: "BLZ", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "55", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	BNCEUR:     {Altname: "BNCEUR", WSName: "BNC/EUR", AClassBase: "currency", Base: "BNC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	BNCUSD:     {Altname: "BNCUSD", WSName: "BNC/USD", AClassBase: "currency", Base: "BNC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	BNTEUR:     {Altname: "BNTEUR", WSName: "BNT/EUR", AClassBase: "currency", Base: "BNT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	BNTUSD:     {Altname: "BNTUSD", WSName: "BNT/USD", AClassBase: "currency", Base: "BNT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	BNTXBT:     {Altname: "BNTXBT", WSName: "BNT/XBT", AClassBase: "currency", Base: "BNT", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	BOBAEUR:    {Altname: "BOBAEUR", WSName: "BOBA/EUR", AClassBase: "currency", Base: "BOBA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	BOBAUSD:    {Altname: "BOBAUSD", WSName: "BOBA/USD", AClassBase: "currency", Base: "BOBA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	BONDEUR:    {Altname: "BONDEUR", WSName: "BOND/EUR", AClassBase: "currency", Base: "BOND", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	BONDUSD:    {Altname: "BONDUSD", WSName: "BOND/USD", AClassBase: "currency", Base: "BOND", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	BRICKEUR:   {Altname: "BRICKEUR", WSName: "BRICK/EUR", AClassBase: "currency", Base: "BRICK", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 5, LotMultiplier: 1, OrderMin: "50", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	BRICKUSD:   {Altname: "BRICKUSD", WSName: "BRICK/USD", AClassBase: "currency", Base: "BRICK", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 5, LotMultiplier: 1, OrderMin: "50", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	BSXEUR:     {Altname: "BSXEUR", WSName: "BSX/EUR", AClassBase: "currency", Base: "BSX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 7, CostDecimals: 11, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35000", CostMin: "0.45", TickSize: "0.0000001", Status: "online"},
+	BSXUSD:     {Altname: "BSXUSD", WSName: "BSX/USD", AClassBase: "currency", Base: "BSX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 7, CostDecimals: 11, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35000", CostMin: "0.5", TickSize: "0.0000001", Status: "online"},
+	BTTEUR:     {Altname: "BTTEUR", WSName: "BTT/EUR", AClassBase: "currency", Base: "BTT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 8, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "7500000", CostMin: "0.45", TickSize: "0.00000001", Status: "online"},
+	BTTUSD:     {Altname: "BTTUSD", WSName: "BTT/USD", AClassBase: "currency", Base: "BTT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 8, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "7500000", CostMin: "0.5", TickSize: "0.00000001", Status: "online"},
+	C98EUR:     {Altname: "C98EUR", WSName: "C98/EUR", AClassBase: "currency", Base: "C98", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	C98USD:     {Altname: "C98USD", WSName: "C98/USD", AClassBase: "currency", Base: "C98", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	CELREUR:    {Altname: "CELREUR", WSName: "CELR/EUR", AClassBase: "currency", Base: "CELR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	CELRUSD:    {Altname: "CELRUSD", WSName: "CELR/USD", AClassBase: "currency", Base: "CELR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	CFGEUR:     {Altname: "CFGEUR", WSName: "CFG/EUR", AClassBase: "currency", Base: "CFG", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	CFGUSD:     {Altname: "CFGUSD", WSName: "CFG/USD", AClassBase: "currency", Base: "CFG", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	CHREUR:     {Altname: "CHREUR", WSName: "CHR/EUR", AClassBase: "currency", Base: "CHR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "28", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	CHRUSD:     {Altname: "CHRUSD", WSName: "CHR/USD", AClassBase: "currency", Base: "CHR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "28", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	CHZEUR:     {Altname: "CHZEUR", WSName: "CHZ/EUR", AClassBase: "currency", Base: "CHZ", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "40", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	CHZUSD:     {Altname: "CHZUSD", WSName: "CHZ/USD", AClassBase: "currency", Base: "CHZ", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "40", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	COMPEUR:    {Altname: "COMPEUR", WSName: "COMP/EUR", AClassBase: "currency", Base: "COMP", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 4, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.11", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	COMPUSD:    {Altname: "COMPUSD", WSName: "COMP/USD", AClassBase: "currency", Base: "COMP", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 4, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.11", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	COMPXBT:    {Altname: "COMPXBT", WSName: "COMP/XBT", AClassBase: "currency", Base: "COMP", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.11", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	COTIEUR:    {Altname: "COTIEUR", WSName: "COTI/EUR", AClassBase: "currency", Base: "COTI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "65", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	COTIUSD:    {Altname: "COTIUSD", WSName: "COTI/USD", AClassBase: "currency", Base: "COTI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "65", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	CQTEUR:     {Altname: "CQTEUR", WSName: "CQT/EUR", AClassBase: "currency", Base: "CQT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	CQTUSD:     {Altname: "CQTUSD", WSName: "CQT/USD", AClassBase: "currency", Base: "CQT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	CRVETH:     {Altname: "CRVETH", WSName: "CRV/ETH", AClassBase: "currency", Base: "CRV", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	CRVEUR:     {Altname: "CRVEUR", WSName: "CRV/EUR", AClassBase: "currency", Base: "CRV", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	CRVUSD:     {Altname: "CRVUSD", WSName: "CRV/USD", AClassBase: "currency", Base: "CRV", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	CRVXBT:     {Altname: "CRVXBT", WSName: "CRV/XBT", AClassBase: "currency", Base: "CRV", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	CSMEUR:     {Altname: "CSMEUR", WSName: "CSM/EUR", AClassBase: "currency", Base: "CSM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "300", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	CSMUSD:     {Altname: "CSMUSD", WSName: "CSM/USD", AClassBase: "currency", Base: "CSM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "300", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	CTSIEUR:    {Altname: "CTSIEUR", WSName: "CTSI/EUR", AClassBase: "currency", Base: "CTSI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "33", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	CTSIUSD:    {Altname: "CTSIUSD", WSName: "CTSI/USD", AClassBase: "currency", Base: "CTSI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "33", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	CVCEUR:     {Altname: "CVCEUR", WSName: "CVC/EUR", AClassBase: "currency", Base: "CVC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	CVCUSD:     {Altname: "CVCUSD", WSName: "CVC/USD", AClassBase: "currency", Base: "CVC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	CVXEUR:     {Altname: "CVXEUR", WSName: "CVX/EUR", AClassBase: "currency", Base: "CVX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.9", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	CVXUSD:     {Altname: "CVXUSD", WSName: "CVX/USD", AClassBase: "currency", Base: "CVX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.9", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	DAIEUR:     {Altname: "DAIEUR", WSName: "DAI/EUR", AClassBase: "currency", Base: "DAI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	DAIUSD:     {Altname: "DAIUSD", WSName: "DAI/USD", AClassBase: "currency", Base: "DAI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	DAIUSDT:    {Altname: "DAIUSDT", WSName: "DAI/USDT", AClassBase: "currency", Base: "DAI", AClassQuote: "currency", Quote: "USDT", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	DASHEUR:    {Altname: "DASHEUR", WSName: "DASH/EUR", AClassBase: "currency", Base: "DASH", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.075", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	DASHUSD:    {Altname: "DASHUSD", WSName: "DASH/USD", AClassBase: "currency", Base: "DASH", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.075", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	DASHXBT:    {Altname: "DASHXBT", WSName: "DASH/XBT", AClassBase: "currency", Base: "DASH", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.075", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	DENTEUR:    {Altname: "DENTEUR", WSName: "DENT/EUR", AClassBase: "currency", Base: "DENT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 7, CostDecimals: 11, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7000", CostMin: "0.45", TickSize: "0.0000001", Status: "online"},
+	DENTUSD:    {Altname: "DENTUSD", WSName: "DENT/USD", AClassBase: "currency", Base: "DENT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 7, CostDecimals: 11, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7000", CostMin: "0.5", TickSize: "0.0000001", Status: "online"},
+	DOTETH:     {Altname: "DOTETH", WSName: "DOT/ETH", AClassBase: "currency", Base: "DOT", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	DOTEUR:     {Altname: "DOTEUR", WSName: "DOT/EUR", AClassBase: "currency", Base: "DOT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	DOTGBP:     {Altname: "DOTGBP", WSName: "DOT/GBP", AClassBase: "currency", Base: "DOT", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.43", TickSize: "0.0001", Status: "online"},
+	DOTJPY:     {Altname: "DOTJPY", WSName: "DOT/JPY", AClassBase: "currency", Base: "DOT", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "50", TickSize: "0.1", Status: "online"},
+	DOTUSD:     {Altname: "DOTUSD", WSName: "DOT/USD", AClassBase: "currency", Base: "DOT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	DOTUSDT:    {Altname: "DOTUSDT", WSName: "DOT/USDT", AClassBase: "currency", Base: "DOT", AClassQuote: "currency", Quote: "USDT", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	DOTXBT:     {Altname: "DOTXBT", WSName: "DOT/XBT", AClassBase: "currency", Base: "DOT", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	DYDXEUR:    {Altname: "DYDXEUR", WSName: "DYDX/EUR", AClassBase: "currency", Base: "DYDX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	DYDXUSD:    {Altname: "DYDXUSD", WSName: "DYDX/USD", AClassBase: "currency", Base: "DYDX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	EGLDEUR:    {Altname: "EGLDEUR", WSName: "EGLD/EUR", AClassBase: "currency", Base: "EGLD", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.15", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	EGLDUSD:    {Altname: "EGLDUSD", WSName: "EGLD/USD", AClassBase: "currency", Base: "EGLD", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.15", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	ENJEUR:     {Altname: "ENJEUR", WSName: "ENJ/EUR", AClassBase: "currency", Base: "ENJ", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	ENJGBP:     {Altname: "ENJGBP", WSName: "ENJ/GBP", AClassBase: "currency", Base: "ENJ", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.43", TickSize: "0.001", Status: "online"},
+	ENJJPY:     {Altname: "ENJJPY", WSName: "ENJ/JPY", AClassBase: "currency", Base: "ENJ", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "50", TickSize: "0.1", Status: "online"},
+	ENJUSD:     {Altname: "ENJUSD", WSName: "ENJ/USD", AClassBase: "currency", Base: "ENJ", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	ENJXBT:     {Altname: "ENJXBT", WSName: "ENJ/XBT", AClassBase: "currency", Base: "ENJ", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	ENSEUR:     {Altname: "ENSEUR", WSName: "ENS/EUR", AClassBase: "currency", Base: "ENS", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.4", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	ENSUSD:     {Altname: "ENSUSD", WSName: "ENS/USD", AClassBase: "currency", Base: "ENS", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.4", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	EOSETH:     {Altname: "EOSETH", WSName: "EOS/ETH", AClassBase: "currency", Base: "EOS", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.0002", TickSize: "0.000001", Status: "reduce_only"},
+	EOSEUR:     {Altname: "EOSEUR", WSName: "EOS/EUR", AClassBase: "currency", Base: "EOS", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	EOSUSD:     {Altname: "EOSUSD", WSName: "EOS/USD", AClassBase: "currency", Base: "EOS", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	EOSUSDT:    {Altname: "EOSUSDT", WSName: "EOS/USDT", AClassBase: "currency", Base: "EOS", AClassQuote: "currency", Quote: "USDT", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	EOSXBT:     {Altname: "EOSXBT", WSName: "EOS/XBT", AClassBase: "currency", Base: "EOS", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	ETH2SETH:   {Altname: "ETH2.SETH", WSName: "ETH2.S/ETH", AClassBase: "currency", Base: "ETH2.S", AClassQuote: "currency", Quote: "XETH", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.001", CostMin: "0.0002", TickSize: "0.0001", Status: "online"},
+	ETHAED:     {Altname: "ETHAED", WSName: "ETH/AED", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "AED", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "3", TickSize: "0.1", Status: "cancel_only"},
+	ETHAUD:     {Altname: "ETHAUD", WSName: "ETH/AUD", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "1", TickSize: "0.01", Status: "online"},
+	ETHCHF:     {Altname: "ETHCHF", WSName: "ETH/CHF", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "CHF", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	ETHDAI:     {Altname: "ETHDAI", WSName: "ETH/DAI", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "DAI", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	ETHUSDC:    {Altname: "ETHUSDC", WSName: "ETH/USDC", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "USDC", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	ETHUSDT:    {Altname: "ETHUSDT", WSName: "ETH/USDT", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "USDT", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	ETHWETH:    {Altname: "ETHWETH", WSName: "ETHW/ETH", AClassBase: "currency", Base: "ETHW", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	ETHWEUR:    {Altname: "ETHWEUR", WSName: "ETHW/EUR", AClassBase: "currency", Base: "ETHW", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	ETHWUSD:    {Altname: "ETHWUSD", WSName: "ETHW/USD", AClassBase: "currency", Base: "ETHW", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	EULEUR:     {Altname: "EULEUR", WSName: "EUL/EUR", AClassBase: "currency", Base: "EUL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	EULUSD:     {Altname: "EULUSD", WSName: "EUL/USD", AClassBase: "currency", Base: "EUL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	EURAUD:     {Altname: "EURAUD", WSName: "EUR/AUD", AClassBase: "currency", Base: "ZEUR", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "1", TickSize: "0.00001", Status: "online"},
+	EURCAD:     {Altname: "EURCAD", WSName: "EUR/CAD", AClassBase: "currency", Base: "ZEUR", AClassQuote: "currency", Quote: "ZCAD", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "1", TickSize: "0.00001", Status: "online"},
+	EURCHF:     {Altname: "EURCHF", WSName: "EUR/CHF", AClassBase: "currency", Base: "ZEUR", AClassQuote: "currency", Quote: "CHF", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	EURGBP:     {Altname: "EURGBP", WSName: "EUR/GBP", AClassBase: "currency", Base: "ZEUR", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.43", TickSize: "0.00001", Status: "online"},
+	EURJPY:     {Altname: "EURJPY", WSName: "EUR/JPY", AClassBase: "currency", Base: "ZEUR", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 3, CostDecimals: 3, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "50", TickSize: "0.001", Status: "online"},
+	EURTEUR:    {Altname: "EURTEUR", WSName: "EURT/EUR", AClassBase: "currency", Base: "EURT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	EURTUSD:    {Altname: "EURTUSD", WSName: "EURT/USD", AClassBase: "currency", Base: "EURT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	EURTUSDT:   {Altname: "EURTUSDT", WSName: "EURT/USDT", AClassBase: "currency", Base: "EURT", AClassQuote: "currency", Quote: "USDT", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	EWTEUR:     {Altname: "EWTEUR", WSName: "EWT/EUR", AClassBase: "currency", Base: "EWT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	EWTGBP:     {Altname: "EWTGBP", WSName: "EWT/GBP", AClassBase: "currency", Base: "EWT", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.43", TickSize: "0.001", Status: "online"},
+	EWTUSD:     {Altname: "EWTUSD", WSName: "EWT/USD", AClassBase: "currency", Base: "EWT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	EWTXBT:     {Altname: "EWTXBT", WSName: "EWT/XBT", AClassBase: "currency", Base: "EWT", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	FARMEUR:    {Altname: "FARMEUR", WSName: "FARM/EUR", AClassBase: "currency", Base: "FARM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.15", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	FARMUSD:    {Altname: "FARMUSD", WSName: "FARM/USD", AClassBase: "currency", Base: "FARM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.15", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	FETEUR:     {Altname: "FETEUR", WSName: "FET/EUR", AClassBase: "currency", Base: "FET", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12.5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	FETUSD:     {Altname: "FETUSD", WSName: "FET/USD", AClassBase: "currency", Base: "FET", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	FIDAEUR:    {Altname: "FIDAEUR", WSName: "FIDA/EUR", AClassBase: "currency", Base: "FIDA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	FIDAUSD:    {Altname: "FIDAUSD", WSName: "FIDA/USD", AClassBase: "currency", Base: "FIDA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	FILETH:     {Altname: "FILETH", WSName: "FIL/ETH", AClassBase: "currency", Base: "FIL", AClassQuote: "currency", Quote: "XETH", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.25", CostMin: "0.0002", TickSize: "0.00001", Status: "online"},
+	FILEUR:     {Altname: "FILEUR", WSName: "FIL/EUR", AClassBase: "currency", Base: "FIL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.25", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	FILGBP:     {Altname: "FILGBP", WSName: "FIL/GBP", AClassBase: "currency", Base: "FIL", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.25", CostMin: "0.43", TickSize: "0.001", Status: "online"},
+	FILUSD:     {Altname: "FILUSD", WSName: "FIL/USD", AClassBase: "currency", Base: "FIL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.25", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	FILXBT:     {Altname: "FILXBT", WSName: "FIL/XBT", AClassBase: "currency", Base: "FIL", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.25", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	FISEUR:     {Altname: "FISEUR", WSName: "FIS/EUR", AClassBase: "currency", Base: "FIS", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "9", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	FISUSD:     {Altname: "FISUSD", WSName: "FIS/USD", AClassBase: "currency", Base: "FIS", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "9", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	FLOWETH:    {Altname: "FLOWETH", WSName: "FLOW/ETH", AClassBase: "currency", Base: "FLOW", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	FLOWEUR:    {Altname: "FLOWEUR", WSName: "FLOW/EUR", AClassBase: "currency", Base: "FLOW", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	FLOWGBP:    {Altname: "FLOWGBP", WSName: "FLOW/GBP", AClassBase: "currency", Base: "FLOW", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.43", TickSize: "0.001", Status: "online"},
+	FLOWUSD:    {Altname: "FLOWUSD", WSName: "FLOW/USD", AClassBase: "currency", Base: "FLOW", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	FLOWXBT:    {Altname: "FLOWXBT", WSName: "FLOW/XBT", AClassBase: "currency", Base: "FLOW", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	FLREUR:     {Altname: "FLREUR", WSName: "FLR/EUR", AClassBase: "currency", Base: "FLR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "125", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	FLRUSD:     {Altname: "FLRUSD", WSName: "FLR/USD", AClassBase: "currency", Base: "FLR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "125", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	FORTHEUR:   {Altname: "FORTHEUR", WSName: "FORTH/EUR", AClassBase: "currency", Base: "FORTH", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	FORTHUSD:   {Altname: "FORTHUSD", WSName: "FORTH/USD", AClassBase: "currency", Base: "FORTH", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	FTMEUR:     {Altname: "FTMEUR", WSName: "FTM/EUR", AClassBase: "currency", Base: "FTM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	FTMUSD:     {Altname: "FTMUSD", WSName: "FTM/USD", AClassBase: "currency", Base: "FTM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	FXSEUR:     {Altname: "FXSEUR", WSName: "FXS/EUR", AClassBase: "currency", Base: "FXS", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	FXSUSD:     {Altname: "FXSUSD", WSName: "FXS/USD", AClassBase: "currency", Base: "FXS", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	GALAEUR:    {Altname: "GALAEUR", WSName: "GALA/EUR", AClassBase: "currency", Base: "GALA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "120", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	GALAUSD:    {Altname: "GALAUSD", WSName: "GALA/USD", AClassBase: "currency", Base: "GALA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "120", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	GALEUR:     {Altname: "GALEUR", WSName: "GAL/EUR", AClassBase: "currency", Base: "GAL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	GALUSD:     {Altname: "GALUSD", WSName: "GAL/USD", AClassBase: "currency", Base: "GAL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	GARIEUR:    {Altname: "GARIEUR", WSName: "GARI/EUR", AClassBase: "currency", Base: "GARI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "85", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	GARIUSD:    {Altname: "GARIUSD", WSName: "GARI/USD", AClassBase: "currency", Base: "GARI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "85", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	GHSTEUR:    {Altname: "GHSTEUR", WSName: "GHST/EUR", AClassBase: "currency", Base: "GHST", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	GHSTUSD:    {Altname: "GHSTUSD", WSName: "GHST/USD", AClassBase: "currency", Base: "GHST", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	GHSTXBT:    {Altname: "GHSTXBT", WSName: "GHST/XBT", AClassBase: "currency", Base: "GHST", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	GLMREUR:    {Altname: "GLMREUR", WSName: "GLMR/EUR", AClassBase: "currency", Base: "GLMR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	GLMRUSD:    {Altname: "GLMRUSD", WSName: "GLMR/USD", AClassBase: "currency", Base: "GLMR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	GMTEUR:     {Altname: "GMTEUR", WSName: "GMT/EUR", AClassBase: "currency", Base: "GMT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	GMTUSD:     {Altname: "GMTUSD", WSName: "GMT/USD", AClassBase: "currency", Base: "GMT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	GMXEUR:     {Altname: "GMXEUR", WSName: "GMX/EUR", AClassBase: "currency", Base: "GMX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	GMXUSD:     {Altname: "GMXUSD", WSName: "GMX/USD", AClassBase: "currency", Base: "GMX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	GNOEUR:     {Altname: "GNOEUR", WSName: "GNO/EUR", AClassBase: "currency", Base: "GNO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	GNOUSD:     {Altname: "GNOUSD", WSName: "GNO/USD", AClassBase: "currency", Base: "GNO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	GNOXBT:     {Altname: "GNOXBT", WSName: "GNO/XBT", AClassBase: "currency", Base: "GNO", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.00002", TickSize: "0.00001", Status: "online"},
+	GRTEUR:     {Altname: "GRTEUR", WSName: "GRT/EUR", AClassBase: "currency", Base: "GRT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	GRTGBP:     {Altname: "GRTGBP", WSName: "GRT/GBP", AClassBase: "currency", Base: "GRT", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.43", TickSize: "0.00001", Status: "online"},
+	GRTUSD:     {Altname: "GRTUSD", WSName: "GRT/USD", AClassBase: "currency", Base: "GRT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	GRTXBT:     {Altname: "GRTXBT", WSName: "GRT/XBT", AClassBase: "currency", Base: "GRT", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	GSTEUR:     {Altname: "GSTEUR", WSName: "GST/EUR", AClassBase: "currency", Base: "GST", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	GSTUSD:     {Altname: "GSTUSD", WSName: "GST/USD", AClassBase: "currency", Base: "GST", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	GTCEUR:     {Altname: "GTCEUR", WSName: "GTC/EUR", AClassBase: "currency", Base: "GTC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	GTCUSD:     {Altname: "GTCUSD", WSName: "GTC/USD", AClassBase: "currency", Base: "GTC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	HDXEUR:     {Altname: "HDXEUR", WSName: "HDX/EUR", AClassBase: "currency", Base: "HDX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "700", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	HDXUSD:     {Altname: "HDXUSD", WSName: "HDX/USD", AClassBase: "currency", Base: "HDX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "700", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	HFTEUR:     {Altname: "HFTEUR", WSName: "HFT/EUR", AClassBase: "currency", Base: "HFT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8.5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	HFTUSD:     {Altname: "HFTUSD", WSName: "HFT/USD", AClassBase: "currency", Base: "HFT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ICPEUR:     {Altname: "ICPEUR", WSName: "ICP/EUR", AClassBase: "currency", Base: "ICP", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	ICPUSD:     {Altname: "ICPUSD", WSName: "ICP/USD", AClassBase: "currency", Base: "ICP", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	ICXETH:     {Altname: "ICXETH", WSName: "ICX/ETH", AClassBase: "currency", Base: "ICX", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.0002", TickSize: "0.0000001", Status: "online"},
+	ICXEUR:     {Altname: "ICXEUR", WSName: "ICX/EUR", AClassBase: "currency", Base: "ICX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	ICXUSD:     {Altname: "ICXUSD", WSName: "ICX/USD", AClassBase: "currency", Base: "ICX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ICXXBT:     {Altname: "ICXXBT", WSName: "ICX/XBT", AClassBase: "currency", Base: "ICX", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	IDEXEUR:    {Altname: "IDEXEUR", WSName: "IDEX/EUR", AClassBase: "currency", Base: "IDEX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "100", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	IDEXUSD:    {Altname: "IDEXUSD", WSName: "IDEX/USD", AClassBase: "currency", Base: "IDEX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "100", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	IMXEUR:     {Altname: "IMXEUR", WSName: "IMX/EUR", AClassBase: "currency", Base: "IMX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	IMXUSD:     {Altname: "IMXUSD", WSName: "IMX/USD", AClassBase: "currency", Base: "IMX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	INJEUR:     {Altname: "INJEUR", WSName: "INJ/EUR", AClassBase: "currency", Base: "INJ", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	INJUSD:     {Altname: "INJUSD", WSName: "INJ/USD", AClassBase: "currency", Base: "INJ", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	INTREUR:    {Altname: "INTREUR", WSName: "INTR/EUR", AClassBase: "currency", Base: "INTR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	INTRUSD:    {Altname: "INTRUSD", WSName: "INTR/USD", AClassBase: "currency", Base: "INTR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	JASMYEUR:   {Altname: "JASMYEUR", WSName: "JASMY/EUR", AClassBase: "currency", Base: "JASMY", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "800", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	JASMYUSD:   {Altname: "JASMYUSD", WSName: "JASMY/USD", AClassBase: "currency", Base: "JASMY", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "800", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	JUNOEUR:    {Altname: "JUNOEUR", WSName: "JUNO/EUR", AClassBase: "currency", Base: "JUNO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "4", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	JUNOUSD:    {Altname: "JUNOUSD", WSName: "JUNO/USD", AClassBase: "currency", Base: "JUNO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "4", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	KAREUR:     {Altname: "KAREUR", WSName: "KAR/EUR", AClassBase: "currency", Base: "KAR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	KARUSD:     {Altname: "KARUSD", WSName: "KAR/USD", AClassBase: "currency", Base: "KAR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	KAVAETH:    {Altname: "KAVAETH", WSName: "KAVA/ETH", AClassBase: "currency", Base: "KAVA", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	KAVAEUR:    {Altname: "KAVAEUR", WSName: "KAVA/EUR", AClassBase: "currency", Base: "KAVA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	KAVAUSD:    {Altname: "KAVAUSD", WSName: "KAVA/USD", AClassBase: "currency", Base: "KAVA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	KAVAXBT:    {Altname: "KAVAXBT", WSName: "KAVA/XBT", AClassBase: "currency", Base: "KAVA", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	KEEPEUR:    {Altname: "KEEPEUR", WSName: "KEEP/EUR", AClassBase: "currency", Base: "KEEP", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.45", TickSize: "0.00001", Status: "reduce_only"},
+	KEEPUSD:    {Altname: "KEEPUSD", WSName: "KEEP/USD", AClassBase: "currency", Base: "KEEP", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.5", TickSize: "0.00001", Status: "reduce_only"},
+	KEEPXBT:    {Altname: "KEEPXBT", WSName: "KEEP/XBT", AClassBase: "currency", Base: "KEEP", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	KEYEUR:     {Altname: "KEYEUR", WSName: "KEY/EUR", AClassBase: "currency", Base: "KEY", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1500", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	KEYUSD:     {Altname: "KEYUSD", WSName: "KEY/USD", AClassBase: "currency", Base: "KEY", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1500", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	KILTEUR:    {Altname: "KILTEUR", WSName: "KILT/EUR", AClassBase: "currency", Base: "KILT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	KILTUSD:    {Altname: "KILTUSD", WSName: "KILT/USD", AClassBase: "currency", Base: "KILT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	KINEUR:     {Altname: "KINEUR", WSName: "KIN/EUR", AClassBase: "currency", Base: "KIN", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 8, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "1200000", CostMin: "0.45", TickSize: "0.00000001", Status: "online"},
+	KINTEUR:    {Altname: "KINTEUR", WSName: "KINT/EUR", AClassBase: "currency", Base: "KINT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6.5", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	KINTUSD:    {Altname: "KINTUSD", WSName: "KINT/USD", AClassBase: "currency", Base: "KINT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6.5", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	KINUSD:     {Altname: "KINUSD", WSName: "KIN/USD", AClassBase: "currency", Base: "KIN", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 8, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "1200000", CostMin: "0.5", TickSize: "0.00000001", Status: "online"},
+	KNCETH:     {Altname: "KNCETH", WSName: "KNC/ETH", AClassBase: "currency", Base: "KNC", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	KNCEUR:     {Altname: "KNCEUR", WSName: "KNC/EUR", AClassBase: "currency", Base: "KNC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	KNCUSD:     {Altname: "KNCUSD", WSName: "KNC/USD", AClassBase: "currency", Base: "KNC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	KNCXBT:     {Altname: "KNCXBT", WSName: "KNC/XBT", AClassBase: "currency", Base: "KNC", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	KP3REUR:    {Altname: "KP3REUR", WSName: "KP3R/EUR", AClassBase: "currency", Base: "KP3R", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.07", CostMin: "0.45", TickSize: "0.1", Status: "online"},
+	KP3RUSD:    {Altname: "KP3RUSD", WSName: "KP3R/USD", AClassBase: "currency", Base: "KP3R", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.07", CostMin: "0.5", TickSize: "0.1", Status: "online"},
+	KSMDOT:     {Altname: "KSMDOT", WSName: "KSM/DOT", AClassBase: "currency", Base: "KSM", AClassQuote: "currency", Quote: "DOT", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.2", CostMin: "0.1", TickSize: "0.01", Status: "online"},
+	KSMETH:     {Altname: "KSMETH", WSName: "KSM/ETH", AClassBase: "currency", Base: "KSM", AClassQuote: "currency", Quote: "XETH", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.2", CostMin: "0.0002", TickSize: "0.00001", Status: "online"},
+	KSMEUR:     {Altname: "KSMEUR", WSName: "KSM/EUR", AClassBase: "currency", Base: "KSM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.2", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	KSMGBP:     {Altname: "KSMGBP", WSName: "KSM/GBP", AClassBase: "currency", Base: "KSM", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.2", CostMin: "0.43", TickSize: "0.01", Status: "online"},
+	KSMUSD:     {Altname: "KSMUSD", WSName: "KSM/USD", AClassBase: "currency", Base: "KSM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.2", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	KSMXBT:     {Altname: "KSMXBT", WSName: "KSM/XBT", AClassBase: "currency", Base: "KSM", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.2", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	LCXEUR:     {Altname: "LCXEUR", WSName: "LCX/EUR", AClassBase: "currency", Base: "LCX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "45", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	LCXUSD:     {Altname: "LCXUSD", WSName: "LCX/USD", AClassBase: "currency", Base: "LCX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "45", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	LDOEUR:     {Altname: "LDOEUR", WSName: "LDO/EUR", AClassBase: "currency", Base: "LDO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	LDOUSD:     {Altname: "LDOUSD", WSName: "LDO/USD", AClassBase: "currency", Base: "LDO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	LINKAUD:    {Altname: "LINKAUD", WSName: "LINK/AUD", AClassBase: "currency", Base: "LINK", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.8", CostMin: "1", TickSize: "0.001", Status: "online"},
+	LINKETH:    {Altname: "LINKETH", WSName: "LINK/ETH", AClassBase: "currency", Base: "LINK", AClassQuote: "currency", Quote: "XETH", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.8", CostMin: "0.0002", TickSize: "0.00000001", Status: "online"},
+	LINKEUR:    {Altname: "LINKEUR", WSName: "LINK/EUR", AClassBase: "currency", Base: "LINK", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.8", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	LINKGBP:    {Altname: "LINKGBP", WSName: "LINK/GBP", AClassBase: "currency", Base: "LINK", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.8", CostMin: "0.43", TickSize: "0.001", Status: "online"},
+	LINKJPY:    {Altname: "LINKJPY", WSName: "LINK/JPY", AClassBase: "currency", Base: "LINK", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.8", CostMin: "50", TickSize: "0.1", Status: "online"},
+	LINKUSD:    {Altname: "LINKUSD", WSName: "LINK/USD", AClassBase: "currency", Base: "LINK", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.8", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	LINKUSDT:   {Altname: "LINKUSDT", WSName: "LINK/USDT", AClassBase: "currency", Base: "LINK", AClassQuote: "currency", Quote: "USDT", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.8", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	LINKXBT:    {Altname: "LINKXBT", WSName: "LINK/XBT", AClassBase: "currency", Base: "LINK", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.8", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	LMWREUR:    {Altname: "LMWREUR", WSName: "LMWR/EUR", AClassBase: "currency", Base: "LMWR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	LMWRUSD:    {Altname: "LMWRUSD", WSName: "LMWR/USD", AClassBase: "currency", Base: "LMWR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	LPTEUR:     {Altname: "LPTEUR", WSName: "LPT/EUR", AClassBase: "currency", Base: "LPT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.65", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	LPTGBP:     {Altname: "LPTGBP", WSName: "LPT/GBP", AClassBase: "currency", Base: "LPT", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.65", CostMin: "0.43", TickSize: "0.01", Status: "online"},
+	LPTUSD:     {Altname: "LPTUSD", WSName: "LPT/USD", AClassBase: "currency", Base: "LPT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.65", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	LPTXBT:     {Altname: "LPTXBT", WSName: "LPT/XBT", AClassBase: "currency", Base: "LPT", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.65", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	LRCEUR:     {Altname: "LRCEUR", WSName: "LRC/EUR", AClassBase: "currency", Base: "LRC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "13", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	LRCUSD:     {Altname: "LRCUSD", WSName: "LRC/USD", AClassBase: "currency", Base: "LRC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "13", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	LSKETH:     {Altname: "LSKETH", WSName: "LSK/ETH", AClassBase: "currency", Base: "LSK", AClassQuote: "currency", Quote: "XETH", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.0002", TickSize: "0.00000001", Status: "online"},
+	LSKEUR:     {Altname: "LSKEUR", WSName: "LSK/EUR", AClassBase: "currency", Base: "LSK", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	LSKUSD:     {Altname: "LSKUSD", WSName: "LSK/USD", AClassBase: "currency", Base: "LSK", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	LSKXBT:     {Altname: "LSKXBT", WSName: "LSK/XBT", AClassBase: "currency", Base: "LSK", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 9, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.00002", TickSize: "0.000000001", Status: "online"},
+	LTCAUD:     {Altname: "LTCAUD", WSName: "LTC/AUD", AClassBase: "currency", Base: "XLTC", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "1", TickSize: "0.01", Status: "online"},
+	LTCETH:     {Altname: "LTCETH", WSName: "LTC/ETH", AClassBase: "currency", Base: "XLTC", AClassQuote: "currency", Quote: "XETH", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.0002", TickSize: "0.00001", Status: "online"},
+	LTCGBP:     {Altname: "LTCGBP", WSName: "LTC/GBP", AClassBase: "currency", Base: "XLTC", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.43", TickSize: "0.00001", Status: "online"},
+	LTCUSDT:    {Altname: "LTCUSDT", WSName: "LTC/USDT", AClassBase: "currency", Base: "XLTC", AClassQuote: "currency", Quote: "USDT", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	LUNA2EUR:   {Altname: "LUNA2EUR", WSName: "LUNA2/EUR", AClassBase: "currency", Base: "LUNA2", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	LUNA2USD:   {Altname: "LUNA2USD", WSName: "LUNA2/USD", AClassBase: "currency", Base: "LUNA2", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	LUNAEUR:    {Altname: "LUNAEUR", WSName: "LUNA/EUR", AClassBase: "currency", Base: "LUNA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 8, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30000", CostMin: "0.45", TickSize: "0.00000001", Status: "online"},
+	LUNAUSD:    {Altname: "LUNAUSD", WSName: "LUNA/USD", AClassBase: "currency", Base: "LUNA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 8, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30000", CostMin: "0.5", TickSize: "0.00000001", Status: "online"},
+	MANAETH:    {Altname: "MANAETH", WSName: "MANA/ETH", AClassBase: "currency", Base: "MANA", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.0002", TickSize: "0.0000001", Status: "online"},
+	MANAEUR:    {Altname: "MANAEUR", WSName: "MANA/EUR", AClassBase: "currency", Base: "MANA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	MANAUSD:    {Altname: "MANAUSD", WSName: "MANA/USD", AClassBase: "currency", Base: "MANA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	MANAUSDT:   {Altname: "MANAUSDT", WSName: "MANA/USDT", AClassBase: "currency", Base: "MANA", AClassQuote: "currency", Quote: "USDT", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	MANAXBT:    {Altname: "MANAXBT", WSName: "MANA/XBT", AClassBase: "currency", Base: "MANA", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	MASKEUR:    {Altname: "MASKEUR", WSName: "MASK/EUR", AClassBase: "currency", Base: "MASK", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	MASKUSD:    {Altname: "MASKUSD", WSName: "MASK/USD", AClassBase: "currency", Base: "MASK", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	MATICEUR:   {Altname: "MATICEUR", WSName: "MATIC/EUR", AClassBase: "currency", Base: "MATIC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "4", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	MATICGBP:   {Altname: "MATICGBP", WSName: "MATIC/GBP", AClassBase: "currency", Base: "MATIC", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "4", CostMin: "0.43", TickSize: "0.0001", Status: "online"},
+	MATICUSD:   {Altname: "MATICUSD", WSName: "MATIC/USD", AClassBase: "currency", Base: "MATIC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "4", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	MATICUSDT:  {Altname: "MATICUSDT", WSName: "MATIC/USDT", AClassBase: "currency", Base: "MATIC", AClassQuote: "currency", Quote: "USDT", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "4", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	MATICXBT:   {Altname: "MATICXBT", WSName: "MATIC/XBT", AClassBase: "currency", Base: "MATIC", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "4", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	MCEUR:      {Altname: "MCEUR", WSName: "MC/EUR", AClassBase: "currency", Base: "MC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "17.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	MCUSD:      {Altname: "MCUSD", WSName: "MC/USD", AClassBase: "currency", Base: "MC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "17.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	MINAEUR:    {Altname: "MINAEUR", WSName: "MINA/EUR", AClassBase: "currency", Base: "MINA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	MINAGBP:    {Altname: "MINAGBP", WSName: "MINA/GBP", AClassBase: "currency", Base: "MINA", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.43", TickSize: "0.001", Status: "online"},
+	MINAUSD:    {Altname: "MINAUSD", WSName: "MINA/USD", AClassBase: "currency", Base: "MINA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	MINAXBT:    {Altname: "MINAXBT", WSName: "MINA/XBT", AClassBase: "currency", Base: "MINA", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	MIREUR:     {Altname: "MIREUR", WSName: "MIR/EUR", AClassBase: "currency", Base: "MIR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	MIRUSD:     {Altname: "MIRUSD", WSName: "MIR/USD", AClassBase: "currency", Base: "MIR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	MKREUR:     {Altname: "MKREUR", WSName: "MKR/EUR", AClassBase: "currency", Base: "MKR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0075", CostMin: "0.45", TickSize: "0.1", Status: "online"},
+	MKRUSD:     {Altname: "MKRUSD", WSName: "MKR/USD", AClassBase: "currency", Base: "MKR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0075", CostMin: "0.5", TickSize: "0.1", Status: "online"},
+	MKRXBT:     {Altname: "MKRXBT", WSName: "MKR/XBT", AClassBase: "currency", Base: "MKR", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0075", CostMin: "0.00002", TickSize: "0.00001", Status: "online"},
+	MNGOEUR:    {Altname: "MNGOEUR", WSName: "MNGO/EUR", AClassBase: "currency", Base: "MNGO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "275", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	MNGOUSD:    {Altname: "MNGOUSD", WSName: "MNGO/USD", AClassBase: "currency", Base: "MNGO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "275", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	MOONEUR:    {Altname: "MOONEUR", WSName: "MOON/EUR", AClassBase: "currency", Base: "MOON", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "10", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	MOONUSD:    {Altname: "MOONUSD", WSName: "MOON/USD", AClassBase: "currency", Base: "MOON", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "10", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	MOVREUR:    {Altname: "MOVREUR", WSName: "MOVR/EUR", AClassBase: "currency", Base: "MOVR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.65", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	MOVRUSD:    {Altname: "MOVRUSD", WSName: "MOVR/USD", AClassBase: "currency", Base: "MOVR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.65", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	MSOLEUR:    {Altname: "MSOLEUR", WSName: "MSOL/EUR", AClassBase: "currency", Base: "MSOL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.225", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	MSOLUSD:    {Altname: "MSOLUSD", WSName: "MSOL/USD", AClassBase: "currency", Base: "MSOL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.225", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	MULTIEUR:   {Altname: "MULTIEUR", WSName: "MULTI/EUR", AClassBase: "currency", Base: "MULTI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.6", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	MULTIUSD:   {Altname: "MULTIUSD", WSName: "MULTI/USD", AClassBase: "currency", Base: "MULTI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.6", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	MVEUR:      {Altname: "MVEUR", WSName: "MV/EUR", AClassBase: "currency", Base: "MV", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	MVUSD:      {Altname: "MVUSD", WSName: "MV/USD", AClassBase: "currency", Base: "MV", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	MXCEUR:     {Altname: "MXCEUR", WSName: "MXC/EUR", AClassBase: "currency", Base: "MXC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "200", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	MXCUSD:     {Altname: "MXCUSD", WSName: "MXC/USD", AClassBase: "currency", Base: "MXC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "200", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	NANOETH:    {Altname: "NANOETH", WSName: "NANO/ETH", AClassBase: "currency", Base: "NANO", AClassQuote: "currency", Quote: "XETH", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.0002", TickSize: "0.00000001", Status: "online"},
+	NANOEUR:    {Altname: "NANOEUR", WSName: "NANO/EUR", AClassBase: "currency", Base: "NANO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	NANOUSD:    {Altname: "NANOUSD", WSName: "NANO/USD", AClassBase: "currency", Base: "NANO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	NANOXBT:    {Altname: "NANOXBT", WSName: "NANO/XBT", AClassBase: "currency", Base: "NANO", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 9, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8", CostMin: "0.00002", TickSize: "0.000000001", Status: "online"},
+	NEAREUR:    {Altname: "NEAREUR", WSName: "NEAR/EUR", AClassBase: "currency", Base: "NEAR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	NEARUSD:    {Altname: "NEARUSD", WSName: "NEAR/USD", AClassBase: "currency", Base: "NEAR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	NMREUR:     {Altname: "NMREUR", WSName: "NMR/EUR", AClassBase: "currency", Base: "NMR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	NMRUSD:     {Altname: "NMRUSD", WSName: "NMR/USD", AClassBase: "currency", Base: "NMR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	NODLEUR:    {Altname: "NODLEUR", WSName: "NODL/EUR", AClassBase: "currency", Base: "NODL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2000", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	NODLUSD:    {Altname: "NODLUSD", WSName: "NODL/USD", AClassBase: "currency", Base: "NODL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2000", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	NYMEUR:     {Altname: "NYMEUR", WSName: "NYM/EUR", AClassBase: "currency", Base: "NYM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	NYMUSD:     {Altname: "NYMUSD", WSName: "NYM/USD", AClassBase: "currency", Base: "NYM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	NYMXBT:     {Altname: "NYMXBT", WSName: "NYM/XBT", AClassBase: "currency", Base: "NYM", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 12, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	OCEANEUR:   {Altname: "OCEANEUR", WSName: "OCEAN/EUR", AClassBase: "currency", Base: "OCEAN", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	OCEANGBP:   {Altname: "OCEANGBP", WSName: "OCEAN/GBP", AClassBase: "currency", Base: "OCEAN", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 4, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12", CostMin: "0.43", TickSize: "0.0001", Status: "online"},
+	OCEANUSD:   {Altname: "OCEANUSD", WSName: "OCEAN/USD", AClassBase: "currency", Base: "OCEAN", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	OCEANXBT:   {Altname: "OCEANXBT", WSName: "OCEAN/XBT", AClassBase: "currency", Base: "OCEAN", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "12", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	OGNEUR:     {Altname: "OGNEUR", WSName: "OGN/EUR", AClassBase: "currency", Base: "OGN", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	OGNUSD:     {Altname: "OGNUSD", WSName: "OGN/USD", AClassBase: "currency", Base: "OGN", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	OMGETH:     {Altname: "OMGETH", WSName: "OMG/ETH", AClassBase: "currency", Base: "OMG", AClassQuote: "currency", Quote: "XETH", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "0.0002", TickSize: "0.00000001", Status: "online"},
+	OMGEUR:     {Altname: "OMGEUR", WSName: "OMG/EUR", AClassBase: "currency", Base: "OMG", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	OMGJPY:     {Altname: "OMGJPY", WSName: "OMG/JPY", AClassBase: "currency", Base: "OMG", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "50", TickSize: "0.1", Status: "online"},
+	OMGUSD:     {Altname: "OMGUSD", WSName: "OMG/USD", AClassBase: "currency", Base: "OMG", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	OMGXBT:     {Altname: "OMGXBT", WSName: "OMG/XBT", AClassBase: "currency", Base: "OMG", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 9, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "0.00002", TickSize: "0.000000001", Status: "online"},
+	ORCAEUR:    {Altname: "ORCAEUR", WSName: "ORCA/EUR", AClassBase: "currency", Base: "ORCA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	ORCAUSD:    {Altname: "ORCAUSD", WSName: "ORCA/USD", AClassBase: "currency", Base: "ORCA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	OXTEUR:     {Altname: "OXTEUR", WSName: "OXT/EUR", AClassBase: "currency", Base: "OXT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "55", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	OXTUSD:     {Altname: "OXTUSD", WSName: "OXT/USD", AClassBase: "currency", Base: "OXT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "55", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	OXTXBT:     {Altname: "OXTXBT", WSName: "OXT/XBT", AClassBase: "currency", Base: "OXT", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "55", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	OXYEUR:     {Altname: "OXYEUR", WSName: "OXY/EUR", AClassBase: "currency", Base: "OXY", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	OXYUSD:     {Altname: "OXYUSD", WSName: "OXY/USD", AClassBase: "currency", Base: "OXY", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	PARAEUR:    {Altname: "PARAEUR", WSName: "PARA/EUR", AClassBase: "currency", Base: "PARA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 7, CostDecimals: 11, LotDecimals: 8, LotMultiplier: 1, OrderMin: "500", CostMin: "0.45", TickSize: "0.0000001", Status: "online"},
+	PARAUSD:    {Altname: "PARAUSD", WSName: "PARA/USD", AClassBase: "currency", Base: "PARA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 7, CostDecimals: 11, LotDecimals: 8, LotMultiplier: 1, OrderMin: "500", CostMin: "0.5", TickSize: "0.0000001", Status: "online"},
+	PAXGETH:    {Altname: "PAXGETH", WSName: "PAXG/ETH", AClassBase: "currency", Base: "PAXG", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.003", CostMin: "0.0002", TickSize: "0.000001", Status: "online"},
+	PAXGEUR:    {Altname: "PAXGEUR", WSName: "PAXG/EUR", AClassBase: "currency", Base: "PAXG", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.003", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	PAXGUSD:    {Altname: "PAXGUSD", WSName: "PAXG/USD", AClassBase: "currency", Base: "PAXG", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.003", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	PAXGXBT:    {Altname: "PAXGXBT", WSName: "PAXG/XBT", AClassBase: "currency", Base: "PAXG", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.003", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	PEPEEUR:    {Altname: "PEPEEUR", WSName: "PEPE/EUR", AClassBase: "currency", Base: "PEPE", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 9, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "500000", CostMin: "0.45", TickSize: "0.000000001", Status: "online"},
+	PEPEUSD:    {Altname: "PEPEUSD", WSName: "PEPE/USD", AClassBase: "currency", Base: "PEPE", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 9, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "500000", CostMin: "0.5", TickSize: "0.000000001", Status: "online"},
+	PERPEUR:    {Altname: "PERPEUR", WSName: "PERP/EUR", AClassBase: "currency", Base: "PERP", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "9", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	PERPUSD:    {Altname: "PERPUSD", WSName: "PERP/USD", AClassBase: "currency", Base: "PERP", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "9", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	PHAEUR:     {Altname: "PHAEUR", WSName: "PHA/EUR", AClassBase: "currency", Base: "PHA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	PHAUSD:     {Altname: "PHAUSD", WSName: "PHA/USD", AClassBase: "currency", Base: "PHA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	PLAEUR:     {Altname: "PLAEUR", WSName: "PLA/EUR", AClassBase: "currency", Base: "PLA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	PLAUSD:     {Altname: "PLAUSD", WSName: "PLA/USD", AClassBase: "currency", Base: "PLA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	POLISEUR:   {Altname: "POLISEUR", WSName: "POLIS/EUR", AClassBase: "currency", Base: "POLIS", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "17", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	POLISUSD:   {Altname: "POLISUSD", WSName: "POLIS/USD", AClassBase: "currency", Base: "POLIS", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "17", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	POLSEUR:    {Altname: "POLSEUR", WSName: "POLS/EUR", AClassBase: "currency", Base: "POLS", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	POLSUSD:    {Altname: "POLSUSD", WSName: "POLS/USD", AClassBase: "currency", Base: "POLS", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	PONDEUR:    {Altname: "PONDEUR", WSName: "POND/EUR", AClassBase: "currency", Base: "POND", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "600", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	PONDUSD:    {Altname: "PONDUSD", WSName: "POND/USD", AClassBase: "currency", Base: "POND", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "600", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	POWREUR:    {Altname: "POWREUR", WSName: "POWR/EUR", AClassBase: "currency", Base: "POWR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	POWRUSD:    {Altname: "POWRUSD", WSName: "POWR/USD", AClassBase: "currency", Base: "POWR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "30", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	PSTAKEEUR:  {Altname: "PSTAKEEUR", WSName: "PSTAKE/EUR", AClassBase: "currency", Base: "PSTAKE", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "40", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	PSTAKEUSD:  {Altname: "PSTAKEUSD", WSName: "PSTAKE/USD", AClassBase: "currency", Base: "PSTAKE", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "40", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	PYUSDEUR:   {Altname: "PYUSDEUR", WSName: "PYUSD/EUR", AClassBase: "currency", Base: "PYUSD", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	PYUSDUSD:   {Altname: "PYUSDUSD", WSName: "PYUSD/USD", AClassBase: "currency", Base: "PYUSD", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	QNTEUR:     {Altname: "QNTEUR", WSName: "QNT/EUR", AClassBase: "currency", Base: "QNT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	QNTUSD:     {Altname: "QNTUSD", WSName: "QNT/USD", AClassBase: "currency", Base: "QNT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.05", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	QTUMETH:    {Altname: "QTUMETH", WSName: "QTUM/ETH", AClassBase: "currency", Base: "QTUM", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.0002", TickSize: "0.0000001", Status: "online"},
+	QTUMEUR:    {Altname: "QTUMEUR", WSName: "QTUM/EUR", AClassBase: "currency", Base: "QTUM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	QTUMUSD:    {Altname: "QTUMUSD", WSName: "QTUM/USD", AClassBase: "currency", Base: "QTUM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	QTUMXBT:    {Altname: "QTUMXBT", WSName: "QTUM/XBT", AClassBase: "currency", Base: "QTUM", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	RADEUR:     {Altname: "RADEUR", WSName: "RAD/EUR", AClassBase: "currency", Base: "RAD", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	RADUSD:     {Altname: "RADUSD", WSName: "RAD/USD", AClassBase: "currency", Base: "RAD", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	RAREEUR:    {Altname: "RAREEUR", WSName: "RARE/EUR", AClassBase: "currency", Base: "RARE", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	RAREUSD:    {Altname: "RAREUSD", WSName: "RARE/USD", AClassBase: "currency", Base: "RARE", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "35", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	RARIEUR:    {Altname: "RARIEUR", WSName: "RARI/EUR", AClassBase: "currency", Base: "RARI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	RARIUSD:    {Altname: "RARIUSD", WSName: "RARI/USD", AClassBase: "currency", Base: "RARI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	RARIXBT:    {Altname: "RARIXBT", WSName: "RARI/XBT", AClassBase: "currency", Base: "RARI", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	RAYEUR:     {Altname: "RAYEUR", WSName: "RAY/EUR", AClassBase: "currency", Base: "RAY", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "18", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	RAYUSD:     {Altname: "RAYUSD", WSName: "RAY/USD", AClassBase: "currency", Base: "RAY", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "18", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	RBCEUR:     {Altname: "RBCEUR", WSName: "RBC/EUR", AClassBase: "currency", Base: "RBC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	RBCUSD:     {Altname: "RBCUSD", WSName: "RBC/USD", AClassBase: "currency", Base: "RBC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	RENEUR:     {Altname: "RENEUR", WSName: "REN/EUR", AClassBase: "currency", Base: "REN", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	RENUSD:     {Altname: "RENUSD", WSName: "REN/USD", AClassBase: "currency", Base: "REN", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	RENXBT:     {Altname: "RENXBT", WSName: "REN/XBT", AClassBase: "currency", Base: "REN", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	REPV2ETH:   {Altname: "REPV2ETH", WSName: "REPV2/ETH", AClassBase: "currency", Base: "REPV2", AClassQuote: "currency", Quote: "XETH", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.0002", TickSize: "0.00001", Status: "online"},
+	REPV2EUR:   {Altname: "REPV2EUR", WSName: "REPV2/EUR", AClassBase: "currency", Base: "REPV2", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	REPV2USD:   {Altname: "REPV2USD", WSName: "REPV2/USD", AClassBase: "currency", Base: "REPV2", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	REPV2XBT:   {Altname: "REPV2XBT", WSName: "REPV2/XBT", AClassBase: "currency", Base: "REPV2", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	REQEUR:     {Altname: "REQEUR", WSName: "REQ/EUR", AClassBase: "currency", Base: "REQ", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	REQUSD:     {Altname: "REQUSD", WSName: "REQ/USD", AClassBase: "currency", Base: "REQ", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	RLCEUR:     {Altname: "RLCEUR", WSName: "RLC/EUR", AClassBase: "currency", Base: "RLC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	RLCUSD:     {Altname: "RLCUSD", WSName: "RLC/USD", AClassBase: "currency", Base: "RLC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	RNDREUR:    {Altname: "RNDREUR", WSName: "RNDR/EUR", AClassBase: "currency", Base: "RNDR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	RNDRUSD:    {Altname: "RNDRUSD", WSName: "RNDR/USD", AClassBase: "currency", Base: "RNDR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	ROOKEUR:    {Altname: "ROOKEUR", WSName: "ROOK/EUR", AClassBase: "currency", Base: "ROOK", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.35", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	ROOKUSD:    {Altname: "ROOKUSD", WSName: "ROOK/USD", AClassBase: "currency", Base: "ROOK", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.35", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	RPLEUR:     {Altname: "RPLEUR", WSName: "RPL/EUR", AClassBase: "currency", Base: "RPL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.125", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	RPLUSD:     {Altname: "RPLUSD", WSName: "RPL/USD", AClassBase: "currency", Base: "RPL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.125", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	RUNEEUR:    {Altname: "RUNEEUR", WSName: "RUNE/EUR", AClassBase: "currency", Base: "RUNE", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	RUNEUSD:    {Altname: "RUNEUSD", WSName: "RUNE/USD", AClassBase: "currency", Base: "RUNE", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	SAMOEUR:    {Altname: "SAMOEUR", WSName: "SAMO/EUR", AClassBase: "currency", Base: "SAMO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1250", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	SAMOUSD:    {Altname: "SAMOUSD", WSName: "SAMO/USD", AClassBase: "currency", Base: "SAMO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1250", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	SANDEUR:    {Altname: "SANDEUR", WSName: "SAND/EUR", AClassBase: "currency", Base: "SAND", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8.5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	SANDGBP:    {Altname: "SANDGBP", WSName: "SAND/GBP", AClassBase: "currency", Base: "SAND", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8.5", CostMin: "0.43", TickSize: "0.0001", Status: "online"},
+	SANDUSD:    {Altname: "SANDUSD", WSName: "SAND/USD", AClassBase: "currency", Base: "SAND", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	SANDXBT:    {Altname: "SANDXBT", WSName: "SAND/XBT", AClassBase: "currency", Base: "SAND", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "8.5", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	SBREUR:     {Altname: "SBREUR", WSName: "SBR/EUR", AClassBase: "currency", Base: "SBR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5000", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	SBRUSD:     {Altname: "SBRUSD", WSName: "SBR/USD", AClassBase: "currency", Base: "SBR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5000", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	SCETH:      {Altname: "SCETH", WSName: "SC/ETH", AClassBase: "currency", Base: "SC", AClassQuote: "currency", Quote: "XETH", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1300", CostMin: "0.0002", TickSize: "0.00000001", Status: "online"},
+	SCEUR:      {Altname: "SCEUR", WSName: "SC/EUR", AClassBase: "currency", Base: "SC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1300", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	SCRTEUR:    {Altname: "SCRTEUR", WSName: "SCRT/EUR", AClassBase: "currency", Base: "SCRT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	SCRTUSD:    {Altname: "SCRTUSD", WSName: "SCRT/USD", AClassBase: "currency", Base: "SCRT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	SCUSD:      {Altname: "SCUSD", WSName: "SC/USD", AClassBase: "currency", Base: "SC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1300", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	SCXBT:      {Altname: "SCXBT", WSName: "SC/XBT", AClassBase: "currency", Base: "SC", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 10, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1300", CostMin: "0.00002", TickSize: "0.0000000001", Status: "online"},
+	SDNEUR:     {Altname: "SDNEUR", WSName: "SDN/EUR", AClassBase: "currency", Base: "SDN", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	SDNUSD:     {Altname: "SDNUSD", WSName: "SDN/USD", AClassBase: "currency", Base: "SDN", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "10", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	SEIEUR:     {Altname: "SEIEUR", WSName: "SEI/EUR", AClassBase: "currency", Base: "SEI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	SEIUSD:     {Altname: "SEIUSD", WSName: "SEI/USD", AClassBase: "currency", Base: "SEI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	SGBEUR:     {Altname: "SGBEUR", WSName: "SGB/EUR", AClassBase: "currency", Base: "SGB", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "500", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	SGBUSD:     {Altname: "SGBUSD", WSName: "SGB/USD", AClassBase: "currency", Base: "SGB", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "500", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	SHIBEUR:    {Altname: "SHIBEUR", WSName: "SHIB/EUR", AClassBase: "currency", Base: "SHIB", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 8, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "500000", CostMin: "0.45", TickSize: "0.00000001", Status: "online"},
+	SHIBUSD:    {Altname: "SHIBUSD", WSName: "SHIB/USD", AClassBase: "currency", Base: "SHIB", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 8, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "500000", CostMin: "0.5", TickSize: "0.00000001", Status: "online"},
+	SHIBUSDT:   {Altname: "SHIBUSDT", WSName: "SHIB/USDT", AClassBase: "currency", Base: "SHIB", AClassQuote: "currency", Quote: "USDT", PairDecimals: 8, CostDecimals: 5, LotDecimals: 5, LotMultiplier: 1, OrderMin: "500000", CostMin: "0.5", TickSize: "0.00000001", Status: "online"},
+	SNXETH:     {Altname: "SNXETH", WSName: "SNX/ETH", AClassBase: "currency", Base: "SNX", AClassQuote: "currency", Quote: "XETH", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.0002", TickSize: "0.00001", Status: "online"},
+	SNXEUR:     {Altname: "SNXEUR", WSName: "SNX/EUR", AClassBase: "currency", Base: "SNX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	SNXUSD:     {Altname: "SNXUSD", WSName: "SNX/USD", AClassBase: "currency", Base: "SNX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	SNXXBT:     {Altname: "SNXXBT", WSName: "SNX/XBT", AClassBase: "currency", Base: "SNX", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	SOLEUR:     {Altname: "SOLEUR", WSName: "SOL/EUR", AClassBase: "currency", Base: "SOL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	SOLGBP:     {Altname: "SOLGBP", WSName: "SOL/GBP", AClassBase: "currency", Base: "SOL", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.43", TickSize: "0.01", Status: "online"},
+	SOLUSD:     {Altname: "SOLUSD", WSName: "SOL/USD", AClassBase: "currency", Base: "SOL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	SOLUSDT:    {Altname: "SOLUSDT", WSName: "SOL/USDT", AClassBase: "currency", Base: "SOL", AClassQuote: "currency", Quote: "USDT", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	SOLXBT:     {Altname: "SOLXBT", WSName: "SOL/XBT", AClassBase: "currency", Base: "SOL", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	SPELLEUR:   {Altname: "SPELLEUR", WSName: "SPELL/EUR", AClassBase: "currency", Base: "SPELL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7500", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	SPELLUSD:   {Altname: "SPELLUSD", WSName: "SPELL/USD", AClassBase: "currency", Base: "SPELL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "7500", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	SRMEUR:     {Altname: "SRMEUR", WSName: "SRM/EUR", AClassBase: "currency", Base: "SRM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	SRMUSD:     {Altname: "SRMUSD", WSName: "SRM/USD", AClassBase: "currency", Base: "SRM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	SRMXBT:     {Altname: "SRMXBT", WSName: "SRM/XBT", AClassBase: "currency", Base: "SRM", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	STEPEUR:    {Altname: "STEPEUR", WSName: "STEP/EUR", AClassBase: "currency", Base: "STEP", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "325", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	STEPUSD:    {Altname: "STEPUSD", WSName: "STEP/USD", AClassBase: "currency", Base: "STEP", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "325", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	STGEUR:     {Altname: "STGEUR", WSName: "STG/EUR", AClassBase: "currency", Base: "STG", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	STGUSD:     {Altname: "STGUSD", WSName: "STG/USD", AClassBase: "currency", Base: "STG", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "6", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	STORJEUR:   {Altname: "STORJEUR", WSName: "STORJ/EUR", AClassBase: "currency", Base: "STORJ", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	STORJUSD:   {Altname: "STORJUSD", WSName: "STORJ/USD", AClassBase: "currency", Base: "STORJ", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	STORJXBT:   {Altname: "STORJXBT", WSName: "STORJ/XBT", AClassBase: "currency", Base: "STORJ", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 9, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.00002", TickSize: "0.000000001", Status: "online"},
+	STXEUR:     {Altname: "STXEUR", WSName: "STX/EUR", AClassBase: "currency", Base: "STX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	STXUSD:     {Altname: "STXUSD", WSName: "STX/USD", AClassBase: "currency", Base: "STX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "20", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	SUIEUR:     {Altname: "SUIEUR", WSName: "SUI/EUR", AClassBase: "currency", Base: "SUI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "0.005", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	SUIUSD:     {Altname: "SUIUSD", WSName: "SUI/USD", AClassBase: "currency", Base: "SUI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "0.005", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	SUPEREUR:   {Altname: "SUPEREUR", WSName: "SUPER/EUR", AClassBase: "currency", Base: "SUPER", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	SUPERUSD:   {Altname: "SUPERUSD", WSName: "SUPER/USD", AClassBase: "currency", Base: "SUPER", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "50", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	SUSHIEUR:   {Altname: "SUSHIEUR", WSName: "SUSHI/EUR", AClassBase: "currency", Base: "SUSHI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	SUSHIGBP:   {Altname: "SUSHIGBP", WSName: "SUSHI/GBP", AClassBase: "currency", Base: "SUSHI", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.43", TickSize: "0.001", Status: "online"},
+	SUSHIUSD:   {Altname: "SUSHIUSD", WSName: "SUSHI/USD", AClassBase: "currency", Base: "SUSHI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	SUSHIXBT:   {Altname: "SUSHIXBT", WSName: "SUSHI/XBT", AClassBase: "currency", Base: "SUSHI", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	SYNEUR:     {Altname: "SYNEUR", WSName: "SYN/EUR", AClassBase: "currency", Base: "SYN", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	SYNUSD:     {Altname: "SYNUSD", WSName: "SYN/USD", AClassBase: "currency", Base: "SYN", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	TBTCEUR:    {Altname: "TBTCEUR", WSName: "TBTC/EUR", AClassBase: "currency", Base: "TBTC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.00025", CostMin: "0.45", TickSize: "0.1", Status: "online"},
+	TBTCUSD:    {Altname: "TBTCUSD", WSName: "TBTC/USD", AClassBase: "currency", Base: "TBTC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.00025", CostMin: "0.5", TickSize: "0.1", Status: "online"},
+	TBTCXBT:    {Altname: "TBTCXBT", WSName: "TBTC/XBT", AClassBase: "currency", Base: "TBTC", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.00025", CostMin: "0.00002", TickSize: "0.00001", Status: "online"},
+	TEEREUR:    {Altname: "TEEREUR", WSName: "TEER/EUR", AClassBase: "currency", Base: "TEER", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	TEERUSD:    {Altname: "TEERUSD", WSName: "TEER/USD", AClassBase: "currency", Base: "TEER", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	TEUR:       {Altname: "TEUR", WSName: "T/EUR", AClassBase: "currency", Base: "T", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "130", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	TLMEUR:     {Altname: "TLMEUR", WSName: "TLM/EUR", AClassBase: "currency", Base: "TLM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	TLMUSD:     {Altname: "TLMUSD", WSName: "TLM/USD", AClassBase: "currency", Base: "TLM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "250", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	TOKEEUR:    {Altname: "TOKEEUR", WSName: "TOKE/EUR", AClassBase: "currency", Base: "TOKE", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	TOKEUSD:    {Altname: "TOKEUSD", WSName: "TOKE/USD", AClassBase: "currency", Base: "TOKE", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	TRUEUR:     {Altname: "TRUEUR", WSName: "TRU/EUR", AClassBase: "currency", Base: "TRU", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "125", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	TRUUSD:     {Altname: "TRUUSD", WSName: "TRU/USD", AClassBase: "currency", Base: "TRU", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "125", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	TRXETH:     {Altname: "TRXETH", WSName: "TRX/ETH", AClassBase: "currency", Base: "TRX", AClassQuote: "currency", Quote: "XETH", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "100", CostMin: "0.0002", TickSize: "0.00000001", Status: "reduce_only"},
+	TRXEUR:     {Altname: "TRXEUR", WSName: "TRX/EUR", AClassBase: "currency", Base: "TRX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "100", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	TRXUSD:     {Altname: "TRXUSD", WSName: "TRX/USD", AClassBase: "currency", Base: "TRX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "100", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	TRXXBT:     {Altname: "TRXXBT", WSName: "TRX/XBT", AClassBase: "currency", Base: "TRX", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 10, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "100", CostMin: "0.00002", TickSize: "0.0000000001", Status: "online"},
+	TUSDPair:   {Altname: "TUSD", WSName: "T/USD", AClassBase: "currency", Base: "T", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "130", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	TUSDEUR:    {Altname: "TUSDEUR", WSName: "TUSD/EUR", AClassBase: "currency", Base: "TUSD", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	TUSDUSD:    {Altname: "TUSDUSD", WSName: "TUSD/USD", AClassBase: "currency", Base: "TUSD", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	TVKEUR:     {Altname: "TVKEUR", WSName: "TVK/EUR", AClassBase: "currency", Base: "TVK", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "110", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	TVKUSD:     {Altname: "TVKUSD", WSName: "TVK/USD", AClassBase: "currency", Base: "TVK", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "110", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	UMAEUR:     {Altname: "UMAEUR", WSName: "UMA/EUR", AClassBase: "currency", Base: "UMA", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	UMAUSD:     {Altname: "UMAUSD", WSName: "UMA/USD", AClassBase: "currency", Base: "UMA", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "3", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	UNFIEUR:    {Altname: "UNFIEUR", WSName: "UNFI/EUR", AClassBase: "currency", Base: "UNFI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	UNFIUSD:    {Altname: "UNFIUSD", WSName: "UNFI/USD", AClassBase: "currency", Base: "UNFI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	UNIETH:     {Altname: "UNIETH", WSName: "UNI/ETH", AClassBase: "currency", Base: "UNI", AClassQuote: "currency", Quote: "XETH", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.0002", TickSize: "0.00001", Status: "online"},
+	UNIEUR:     {Altname: "UNIEUR", WSName: "UNI/EUR", AClassBase: "currency", Base: "UNI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	UNIUSD:     {Altname: "UNIUSD", WSName: "UNI/USD", AClassBase: "currency", Base: "UNI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	UNIXBT:     {Altname: "UNIXBT", WSName: "UNI/XBT", AClassBase: "currency", Base: "UNI", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	USDAED:     {Altname: "USDAED", WSName: "USD/AED", AClassBase: "currency", Base: "ZUSD", AClassQuote: "currency", Quote: "AED", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "3", TickSize: "0.001", Status: "online"},
+	USDCAUD:    {Altname: "USDCAUD", WSName: "USDC/AUD", AClassBase: "currency", Base: "USDC", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "1", TickSize: "0.0001", Status: "online"},
+	USDCCAD:    {Altname: "USDCCAD", WSName: "USDC/CAD", AClassBase: "currency", Base: "USDC", AClassQuote: "currency", Quote: "ZCAD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "1", TickSize: "0.0001", Status: "online"},
+	USDCCHF:    {Altname: "USDCCHF", WSName: "USDC/CHF", AClassBase: "currency", Base: "USDC", AClassQuote: "currency", Quote: "CHF", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	USDCEUR:    {Altname: "USDCEUR", WSName: "USDC/EUR", AClassBase: "currency", Base: "USDC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	USDCGBP:    {Altname: "USDCGBP", WSName: "USDC/GBP", AClassBase: "currency", Base: "USDC", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.43", TickSize: "0.0001", Status: "online"},
+	USDCHF:     {Altname: "USDCHF", WSName: "USD/CHF", AClassBase: "currency", Base: "ZUSD", AClassQuote: "currency", Quote: "CHF", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	USDCUSD:    {Altname: "USDCUSD", WSName: "USDC/USD", AClassBase: "currency", Base: "USDC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	USDCUSDT:   {Altname: "USDCUSDT", WSName: "USDC/USDT", AClassBase: "currency", Base: "USDC", AClassQuote: "currency", Quote: "USDT", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	USDTAUD:    {Altname: "USDTAUD", WSName: "USDT/AUD", AClassBase: "currency", Base: "USDT", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "1", TickSize: "0.0001", Status: "online"},
+	USDTCAD:    {Altname: "USDTCAD", WSName: "USDT/CAD", AClassBase: "currency", Base: "USDT", AClassQuote: "currency", Quote: "ZCAD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "1", TickSize: "0.0001", Status: "online"},
+	USDTCHF:    {Altname: "USDTCHF", WSName: "USDT/CHF", AClassBase: "currency", Base: "USDT", AClassQuote: "currency", Quote: "CHF", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	USDTEUR:    {Altname: "USDTEUR", WSName: "USDT/EUR", AClassBase: "currency", Base: "USDT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	USDTGBP:    {Altname: "USDTGBP", WSName: "USDT/GBP", AClassBase: "currency", Base: "USDT", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.43", TickSize: "0.0001", Status: "online"},
+	USDTJPY:    {Altname: "USDTJPY", WSName: "USDT/JPY", AClassBase: "currency", Base: "USDT", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "50", TickSize: "0.001", Status: "online"},
+	USDTZUSD:   {Altname: "USDTUSD", WSName: "USDT/USD", AClassBase: "currency", Base: "USDT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	USTEUR:     {Altname: "USTEUR", WSName: "UST/EUR", AClassBase: "currency", Base: "UST", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "175", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	USTUSD:     {Altname: "USTUSD", WSName: "UST/USD", AClassBase: "currency", Base: "UST", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "175", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	USTUSDC:    {Altname: "USTUSDC", WSName: "UST/USDC", AClassBase: "currency", Base: "UST", AClassQuote: "currency", Quote: "USDC", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "175", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	USTUSDT:    {Altname: "USTUSDT", WSName: "UST/USDT", AClassBase: "currency", Base: "UST", AClassQuote: "currency", Quote: "USDT", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "175", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	WAVESETH:   {Altname: "WAVESETH", WSName: "WAVES/ETH", AClassBase: "currency", Base: "WAVES", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.0002", TickSize: "0.0000001", Status: "online"},
+	WAVESEUR:   {Altname: "WAVESEUR", WSName: "WAVES/EUR", AClassBase: "currency", Base: "WAVES", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	WAVESUSD:   {Altname: "WAVESUSD", WSName: "WAVES/USD", AClassBase: "currency", Base: "WAVES", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	WAVESXBT:   {Altname: "WAVESXBT", WSName: "WAVES/XBT", AClassBase: "currency", Base: "WAVES", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "2.5", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	WAXLEUR:    {Altname: "WAXLEUR", WSName: "WAXL/EUR", AClassBase: "currency", Base: "WAXL", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "7", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	WAXLUSD:    {Altname: "WAXLUSD", WSName: "WAXL/USD", AClassBase: "currency", Base: "WAXL", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 8, LotDecimals: 5, LotMultiplier: 1, OrderMin: "7", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	WBTCEUR:    {Altname: "WBTCEUR", WSName: "WBTC/EUR", AClassBase: "currency", Base: "WBTC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.00025", CostMin: "0.45", TickSize: "0.1", Status: "online"},
+	WBTCUSD:    {Altname: "WBTCUSD", WSName: "WBTC/USD", AClassBase: "currency", Base: "WBTC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.00025", CostMin: "0.5", TickSize: "0.1", Status: "online"},
+	WBTCXBT:    {Altname: "WBTCXBT", WSName: "WBTC/XBT", AClassBase: "currency", Base: "WBTC", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 5, CostDecimals: 7, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.00025", CostMin: "0.00002", TickSize: "0.00001", Status: "online"},
+	WOOEUR:     {Altname: "WOOEUR", WSName: "WOO/EUR", AClassBase: "currency", Base: "WOO", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	WOOUSD:     {Altname: "WOOUSD", WSName: "WOO/USD", AClassBase: "currency", Base: "WOO", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	XBTAED:     {Altname: "XBTAED", WSName: "XBT/AED", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "AED", PairDecimals: 0, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "3", TickSize: "1", Status: "cancel_only"},
+	XBTAUD:     {Altname: "XBTAUD", WSName: "XBT/AUD", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "1", TickSize: "0.1", Status: "online"},
+	XBTCHF:     {Altname: "XBTCHF", WSName: "XBT/CHF", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "CHF", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "0.5", TickSize: "0.1", Status: "online"},
+	XBTDAI:     {Altname: "XBTDAI", WSName: "XBT/DAI", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "DAI", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "0.5", TickSize: "0.1", Status: "online"},
+	XBTUSDC:    {Altname: "XBTUSDC", WSName: "XBT/USDC", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "USDC", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	XBTUSDT:    {Altname: "XBTUSDT", WSName: "XBT/USDT", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "USDT", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "0.5", TickSize: "0.1", Status: "online"},
+	XCNEUR:     {Altname: "XCNEUR", WSName: "XCN/EUR", AClassBase: "currency", Base: "XCN", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "300", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	XCNUSD:     {Altname: "XCNUSD", WSName: "XCN/USD", AClassBase: "currency", Base: "XCN", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "300", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	XDGEUR:     {Altname: "XDGEUR", WSName: "XDG/EUR", AClassBase: "currency", Base: "XXDG", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.45", TickSize: "0.0000001", Status: "online"},
+	XDGUSD:     {Altname: "XDGUSD", WSName: "XDG/USD", AClassBase: "currency", Base: "XXDG", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 7, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.5", TickSize: "0.0000001", Status: "online"},
+	XDGUSDT:    {Altname: "XDGUSDT", WSName: "XDG/USDT", AClassBase: "currency", Base: "XXDG", AClassQuote: "currency", Quote: "USDT", PairDecimals: 5, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	XETCXETH:   {Altname: "ETCETH", WSName: "ETC/ETH", AClassBase: "currency", Base: "XETC", AClassQuote: "currency", Quote: "XETH", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.0002", TickSize: "0.000001", Status: "reduce_only"},
+	XETCXXBT:   {Altname: "ETCXBT", WSName: "ETC/XBT", AClassBase: "currency", Base: "XETC", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.00002", TickSize: "0.000001", Status: "reduce_only"},
+	XETCZEUR:   {Altname: "ETCEUR", WSName: "ETC/EUR", AClassBase: "currency", Base: "XETC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	XETCZUSD:   {Altname: "ETCUSD", WSName: "ETC/USD", AClassBase: "currency", Base: "XETC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	XETHXXBT:   {Altname: "ETHXBT", WSName: "ETH/XBT", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "0.00002", TickSize: "0.00001", Status: "online"},
+	XETHZCAD:   {Altname: "ETHCAD", WSName: "ETH/CAD", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "ZCAD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "1", TickSize: "0.01", Status: "online"},
+	XETHZEUR:   {Altname: "ETHEUR", WSName: "ETH/EUR", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	XETHZGBP:   {Altname: "ETHGBP", WSName: "ETH/GBP", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "0.43", TickSize: "0.01", Status: "online"},
+	XETHZJPY:   {Altname: "ETHJPY", WSName: "ETH/JPY", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 0, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "50", TickSize: "1", Status: "online"},
+	XETHZUSD:   {Altname: "ETHUSD", WSName: "ETH/USD", AClassBase: "currency", Base: "XETH", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.01", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	XLTCXXBT:   {Altname: "LTCXBT", WSName: "LTC/XBT", AClassBase: "currency", Base: "XLTC", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	XLTCZEUR:   {Altname: "LTCEUR", WSName: "LTC/EUR", AClassBase: "currency", Base: "XLTC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	XLTCZJPY:   {Altname: "LTCJPY", WSName: "LTC/JPY", AClassBase: "currency", Base: "XLTC", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 0, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "50", TickSize: "1", Status: "online"},
+	XLTCZUSD:   {Altname: "LTCUSD", WSName: "LTC/USD", AClassBase: "currency", Base: "XLTC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.06", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	XMLNXETH:   {Altname: "MLNETH", WSName: "MLN/ETH", AClassBase: "currency", Base: "XMLN", AClassQuote: "currency", Quote: "XETH", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.0002", TickSize: "0.00001", Status: "online"},
+	XMLNXXBT:   {Altname: "MLNXBT", WSName: "MLN/XBT", AClassBase: "currency", Base: "XMLN", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	XMLNZEUR:   {Altname: "MLNEUR", WSName: "MLN/EUR", AClassBase: "currency", Base: "XMLN", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	XMLNZUSD:   {Altname: "MLNUSD", WSName: "MLN/USD", AClassBase: "currency", Base: "XMLN", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.25", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	XMRUSDT:    {Altname: "XMRUSDT", WSName: "XMR/USDT", AClassBase: "currency", Base: "XXMR", AClassQuote: "currency", Quote: "USDT", PairDecimals: 2, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.035", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	XREPXXBT:   {Altname: "REPXBT", WSName: "REP/XBT", AClassBase: "currency", Base: "XREP", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.00002", TickSize: "0.000001", Status: "reduce_only"},
+	XREPZEUR:   {Altname: "REPEUR", WSName: "REP/EUR", AClassBase: "currency", Base: "XREP", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.001", Status: "reduce_only"},
+	XREPZUSD:   {Altname: "REPUSD", WSName: "REP/USD", AClassBase: "currency", Base: "XREP", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	XRPAUD:     {Altname: "XRPAUD", WSName: "XRP/AUD", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "1", TickSize: "0.00001", Status: "online"},
+	XRPETH:     {Altname: "XRPETH", WSName: "XRP/ETH", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.0002", TickSize: "0.0000001", Status: "online"},
+	XRPGBP:     {Altname: "XRPGBP", WSName: "XRP/GBP", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.43", TickSize: "0.00001", Status: "online"},
+	XRPUSDT:    {Altname: "XRPUSDT", WSName: "XRP/USDT", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "USDT", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	XRTEUR:     {Altname: "XRTEUR", WSName: "XRT/EUR", AClassBase: "currency", Base: "XRT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	XRTUSD:     {Altname: "XRTUSD", WSName: "XRT/USD", AClassBase: "currency", Base: "XRT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "1", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	XTZAUD:     {Altname: "XTZAUD", WSName: "XTZ/AUD", AClassBase: "currency", Base: "XTZ", AClassQuote: "currency", Quote: "ZAUD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "1", TickSize: "0.0001", Status: "online"},
+	XTZETH:     {Altname: "XTZETH", WSName: "XTZ/ETH", AClassBase: "currency", Base: "XTZ", AClassQuote: "currency", Quote: "XETH", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.0002", TickSize: "0.0000001", Status: "reduce_only"},
+	XTZEUR:     {Altname: "XTZEUR", WSName: "XTZ/EUR", AClassBase: "currency", Base: "XTZ", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.45", TickSize: "0.0001", Status: "online"},
+	XTZGBP:     {Altname: "XTZGBP", WSName: "XTZ/GBP", AClassBase: "currency", Base: "XTZ", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.43", TickSize: "0.0001", Status: "online"},
+	XTZUSD:     {Altname: "XTZUSD", WSName: "XTZ/USD", AClassBase: "currency", Base: "XTZ", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	XTZUSDT:    {Altname: "XTZUSDT", WSName: "XTZ/USDT", AClassBase: "currency", Base: "XTZ", AClassQuote: "currency", Quote: "USDT", PairDecimals: 4, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.0001", Status: "online"},
+	XTZXBT:     {Altname: "XTZXBT", WSName: "XTZ/XBT", AClassBase: "currency", Base: "XTZ", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 7, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.00002", TickSize: "0.0000001", Status: "online"},
+	XXBTZCAD:   {Altname: "XBTCAD", WSName: "XBT/CAD", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "ZCAD", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "1", TickSize: "0.1", Status: "online"},
+	XXBTZEUR:   {Altname: "XBTEUR", WSName: "XBT/EUR", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "0.45", TickSize: "0.1", Status: "online"},
+	XXBTZGBP:   {Altname: "XBTGBP", WSName: "XBT/GBP", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "0.43", TickSize: "0.1", Status: "online"},
+	XXBTZJPY:   {Altname: "XBTJPY", WSName: "XBT/JPY", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 0, CostDecimals: 3, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "50", TickSize: "1", Status: "online"},
+	XXBTZUSD:   {Altname: "XBTUSD", WSName: "XBT/USD", AClassBase: "currency", Base: "XXBT", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 1, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0001", CostMin: "0.5", TickSize: "0.1", Status: "online"},
+	XXDGXXBT:   {Altname: "XDGXBT", WSName: "XDG/XBT", AClassBase: "currency", Base: "XXDG", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	XXLMXXBT:   {Altname: "XLMXBT", WSName: "XLM/XBT", AClassBase: "currency", Base: "XXLM", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	XXLMZEUR:   {Altname: "XLMEUR", WSName: "XLM/EUR", AClassBase: "currency", Base: "XXLM", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.45", TickSize: "0.000001", Status: "online"},
+	XXLMZGBP:   {Altname: "XLMGBP", WSName: "XLM/GBP", AClassBase: "currency", Base: "XXLM", AClassQuote: "currency", Quote: "ZGBP", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.43", TickSize: "0.00001", Status: "online"},
+	XXLMZUSD:   {Altname: "XLMUSD", WSName: "XLM/USD", AClassBase: "currency", Base: "XXLM", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "60", CostMin: "0.5", TickSize: "0.000001", Status: "online"},
+	XXMRXXBT:   {Altname: "XMRXBT", WSName: "XMR/XBT", AClassBase: "currency", Base: "XXMR", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 6, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.035", CostMin: "0.00002", TickSize: "0.000001", Status: "online"},
+	XXMRZEUR:   {Altname: "XMREUR", WSName: "XMR/EUR", AClassBase: "currency", Base: "XXMR", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 2, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.035", CostMin: "0.45", TickSize: "0.01", Status: "online"},
+	XXMRZUSD:   {Altname: "XMRUSD", WSName: "XMR/USD", AClassBase: "currency", Base: "XXMR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.035", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	XXRPXXBT:   {Altname: "XRPXBT", WSName: "XRP/XBT", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 9, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	XXRPZCAD:   {Altname: "XRPCAD", WSName: "XRP/CAD", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "ZCAD", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "1", TickSize: "0.00001", Status: "online"},
+	XXRPZEUR:   {Altname: "XRPEUR", WSName: "XRP/EUR", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.45", TickSize: "0.00001", Status: "online"},
+	XXRPZJPY:   {Altname: "XRPJPY", WSName: "XRP/JPY", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 3, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "50", TickSize: "0.001", Status: "online"},
+	XXRPZUSD:   {Altname: "XRPUSD", WSName: "XRP/USD", AClassBase: "currency", Base: "XXRP", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 8, LotDecimals: 8, LotMultiplier: 1, OrderMin: "15", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	XZECXXBT:   {Altname: "ZECXBT", WSName: "ZEC/XBT", AClassBase: "currency", Base: "XZEC", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 5, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.15", CostMin: "0.00002", TickSize: "0.00001", Status: "online"},
+	XZECZEUR:   {Altname: "ZECEUR", WSName: "ZEC/EUR", AClassBase: "currency", Base: "XZEC", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.15", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	XZECZUSD:   {Altname: "ZECUSD", WSName: "ZEC/USD", AClassBase: "currency", Base: "XZEC", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 2, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.15", CostMin: "0.5", TickSize: "0.01", Status: "online"},
+	YFIEUR:     {Altname: "YFIEUR", WSName: "YFI/EUR", AClassBase: "currency", Base: "YFI", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 0, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0007", CostMin: "0.45", TickSize: "1", Status: "online"},
+	YFIUSD:     {Altname: "YFIUSD", WSName: "YFI/USD", AClassBase: "currency", Base: "YFI", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 0, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0007", CostMin: "0.5", TickSize: "1", Status: "online"},
+	YFIXBT:     {Altname: "YFIXBT", WSName: "YFI/XBT", AClassBase: "currency", Base: "YFI", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 4, CostDecimals: 6, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.0007", CostMin: "0.00002", TickSize: "0.0001", Status: "online"},
+	YGGEUR:     {Altname: "YGGEUR", WSName: "YGG/EUR", AClassBase: "currency", Base: "YGG", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "17.5", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	YGGUSD:     {Altname: "YGGUSD", WSName: "YGG/USD", AClassBase: "currency", Base: "YGG", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "17.5", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	ZEURZUSD:   {Altname: "EURUSD", WSName: "EUR/USD", AClassBase: "currency", Base: "ZEUR", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "0.5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ZGBPZUSD:   {Altname: "GBPUSD", WSName: "GBP/USD", AClassBase: "currency", Base: "ZGBP", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "0.5", TickSize: "0.00001", Status: "online"},
+	ZRXEUR:     {Altname: "ZRXEUR", WSName: "ZRX/EUR", AClassBase: "currency", Base: "ZRX", AClassQuote: "currency", Quote: "ZEUR", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.45", TickSize: "0.001", Status: "online"},
+	ZRXUSD:     {Altname: "ZRXUSD", WSName: "ZRX/USD", AClassBase: "currency", Base: "ZRX", AClassQuote: "currency", Quote: "ZUSD", PairDecimals: 3, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.5", TickSize: "0.001", Status: "online"},
+	ZRXXBT:     {Altname: "ZRXXBT", WSName: "ZRX/XBT", AClassBase: "currency", Base: "ZRX", AClassQuote: "currency", Quote: "XXBT", PairDecimals: 8, CostDecimals: 10, LotDecimals: 8, LotMultiplier: 1, OrderMin: "25", CostMin: "0.00002", TickSize: "0.00000001", Status: "online"},
+	ZUSDZCAD:   {Altname: "USDCAD", WSName: "USD/CAD", AClassBase: "currency", Base: "ZUSD", AClassQuote: "currency", Quote: "ZCAD", PairDecimals: 5, CostDecimals: 5, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "1", TickSize: "0.00001", Status: "online"},
+	ZUSDZJPY:   {Altname: "USDJPY", WSName: "USD/JPY", AClassBase: "currency", Base: "ZUSD", AClassQuote: "currency", Quote: "ZJPY", PairDecimals: 3, CostDecimals: 3, LotDecimals: 8, LotMultiplier: 1, OrderMin: "5", CostMin: "50", TickSize: "0.001", Status: "online"},
+}
@@ -5,6 +5,13 @@ import "context"
 // Secret represents a Kraken API secret.
 type Secret []byte
 
+// Zero overwrites the secret material with zeros.
+func (s Secret) Zero() {
+	for i := range s {
+		s[i] = 0
+	}
+}
+
 // APIKey represents a Kraken API key.
 type APIKey string
 
@@ -0,0 +1,338 @@
+package kraken
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// averagePricePlaces is the number of decimal places of the prices computed from a fill.
+const averagePricePlaces = 10
+
+// ErrInsufficientLiquidity is returned when the book can not fill the requested volume.
+var ErrInsufficientLiquidity = errors.New("insufficient liquidity in the order book")
+
+// BookLevel represents a price level of an order book.
+type BookLevel struct {
+	Price  Decimal
+	Volume Decimal
+	Time   time.Time
+}
+
+// DepthLevel represents a price level of an order book with the volume and cost
+// accumulated from the best price up to and including the level.
+type DepthLevel struct {
+	BookLevel
+	CumulativeVolume Decimal
+	CumulativeCost   Decimal
+}
+
+// Fill represents the result of filling a volume against an order book.
+type Fill struct {
+	Volume       Decimal
+	Cost         Decimal
+	AveragePrice Decimal // Volume weighted average price of the fill.
+	WorstPrice   Decimal // Price of the last level reached by the fill.
+}
+
+// Book is a typed order book. Asks are sorted by ascending price and bids by descending price,
+// so the best prices come first. A Book can be built from the REST OrderBook or from a streamed
+// snapshot and kept up to date with Update.
+type Book struct {
+	Asks []BookLevel
+	Bids []BookLevel
+}
+
+// NewBook returns a new Book with the given levels sorted from the best price.
+func NewBook(asks, bids []BookLevel) *Book {
+	b := &Book{
+		Asks: append([]BookLevel(nil), asks...),
+		Bids: append([]BookLevel(nil), bids...),
+	}
+	b.sort()
+	return b
+}
+
+// Book converts the order book into a typed Book.
+// An error is returned if any entry does not have the expected shape.
+func (o OrderBook) Book() (*Book, error) {
+	asks, err := bookLevels(o.Asks)
+	if err != nil {
+		return nil, fmt.Errorf("asks: %w", err)
+	}
+
+	bids, err := bookLevels(o.Bids)
+	if err != nil {
+		return nil, fmt.Errorf("bids: %w", err)
+	}
+
+	return NewBook(asks, bids), nil
+}
+
+// BookLevel converts the entry into a typed BookLevel.
+func (o OrderBookEntries) BookLevel() (BookLevel, error) {
+	if len(o) < 3 {
+		return BookLevel{}, fmt.Errorf("expected [<price>, <volume>, <timestamp>], got %v", []any(o))
+	}
+
+	price, err := decimalValue(o[0])
+	if err != nil {
+		return BookLevel{}, fmt.Errorf("price: %w", err)
+	}
+
+	volume, err := decimalValue(o[1])
+	if err != nil {
+		return BookLevel{}, fmt.Errorf("volume: %w", err)
+	}
+
+	ts, err := timeValue(o[2])
+	if err != nil {
+		return BookLevel{}, fmt.Errorf("timestamp: %w", err)
+	}
+
+	return BookLevel{Price: price, Volume: volume, Time: ts}, nil
+}
+
+// BestAsk returns the lowest ask. It returns false if there are no asks.
+func (b *Book) BestAsk() (BookLevel, bool) {
+	if len(b.Asks) == 0 {
+		return BookLevel{}, false
+	}
+	return b.Asks[0], true
+}
+
+// BestBid returns the highest bid. It returns false if there are no bids.
+func (b *Book) BestBid() (BookLevel, bool) {
+	if len(b.Bids) == 0 {
+		return BookLevel{}, false
+	}
+	return b.Bids[0], true
+}
+
+// Mid returns the price halfway between the best ask and the best bid.
+// It returns false if any side of the book is empty.
+func (b *Book) Mid() (Decimal, bool) {
+	ask, okAsk := b.BestAsk()
+	bid, okBid := b.BestBid()
+	if !okAsk || !okBid {
+		return "", false
+	}
+	return ask.Price.Add(bid.Price).Mul("0.5"), true
+}
+
+// Spread returns the difference between the best ask and the best bid.
+// It returns false if any side of the book is empty.
+func (b *Book) Spread() (Decimal, bool) {
+	ask, okAsk := b.BestAsk()
+	bid, okBid := b.BestBid()
+	if !okAsk || !okBid {
+		return "", false
+	}
+	return ask.Price.Sub(bid.Price), true
+}
+
+// Depth returns the levels consumed by an order in the given direction, i.e. the asks
+// for a buy and the bids for a sell, with their cumulative volume and cost.
+func (b *Book) Depth(direction OrderDirection) []DepthLevel {
+	levels := b.side(direction)
+
+	depth := make([]DepthLevel, len(levels))
+
+	var volume, cost Decimal = "0", "0"
+	for i, l := range levels {
+		volume = volume.Add(l.Volume)
+		cost = cost.Add(l.Price.Mul(l.Volume))
+
+		depth[i] = DepthLevel{BookLevel: l, CumulativeVolume: volume, CumulativeCost: cost}
+	}
+
+	return depth
+}
+
+// Fill simulates a market order of the given volume in the given direction, walking the
+// levels from the best price. ErrInsufficientLiquidity is returned if the book can not fill
+// the whole volume.
+func (b *Book) Fill(direction OrderDirection, volume Decimal) (Fill, error) {
+	if volume.Sign() <= 0 {
+		return Fill{}, fmt.Errorf("volume must be positive, got %q", volume)
+	}
+
+	f := Fill{Volume: "0", Cost: "0"}
+
+	remaining := volume
+	for _, l := range b.side(direction) {
+		if remaining.Sign() <= 0 {
+			break
+		}
+
+		take := l.Volume
+		if take.Cmp(remaining) > 0 {
+			take = remaining
+		}
+
+		f.Volume = f.Volume.Add(take)
+		f.Cost = f.Cost.Add(l.Price.Mul(take))
+		f.WorstPrice = l.Price
+		remaining = remaining.Sub(take)
+	}
+
+	if remaining.Sign() > 0 {
+		return Fill{}, fmt.Errorf("%w: %s of %s available", ErrInsufficientLiquidity, f.Volume, volume)
+	}
+
+	f.AveragePrice, _ = f.Cost.Div(f.Volume, averagePricePlaces)
+
+	return f, nil
+}
+
+// VWAP returns the volume weighted average price to fill the given volume in the given direction.
+func (b *Book) VWAP(direction OrderDirection, volume Decimal) (Decimal, error) {
+	f, err := b.Fill(direction, volume)
+	if err != nil {
+		return "", err
+	}
+	return f.AveragePrice, nil
+}
+
+// Slippage estimates the slippage of a market order of the given volume as the relative
+// difference between its average price and the best price, e.g. "0.001" for 0.1%.
+// The slippage is positive when the order is filled at a worse price than the best one.
+func (b *Book) Slippage(direction OrderDirection, volume Decimal) (Decimal, error) {
+	f, err := b.Fill(direction, volume)
+	if err != nil {
+		return "", err
+	}
+
+	// Computed from the exact cost instead of the rounded average price.
+	bestCost := b.side(direction)[0].Price.Mul(f.Volume)
+
+	diff := f.Cost.Sub(bestCost)
+	if direction == Sell {
+		diff = diff.Neg()
+	}
+
+	return diff.Div(bestCost, averagePricePlaces)
+}
+
+// Update applies the levels of a streamed update to the asks or the bids, depending on the direction
+// of the orders resting on that side: Sell for asks and Buy for bids. A level with zero volume removes
+// the price level, any other level replaces it. If depth is positive, the side is truncated to that
+// number of levels, as Kraken only streams updates within the subscribed depth.
+func (b *Book) Update(side OrderDirection, depth int, levels ...BookLevel) {
+	current := b.Bids
+	if side == Sell {
+		current = b.Asks
+	}
+
+	for _, l := range levels {
+		i := sort.Search(len(current), func(i int) bool {
+			return !better(side, current[i].Price, l.Price)
+		})
+
+		found := i < len(current) && current[i].Price.Equal(l.Price)
+
+		switch {
+		case l.Volume.IsZero() && found:
+			current = append(current[:i], current[i+1:]...)
+		case l.Volume.IsZero():
+		case found:
+			current[i] = l
+		default:
+			current = append(current, BookLevel{})
+			copy(current[i+1:], current[i:])
+			current[i] = l
+		}
+	}
+
+	if depth > 0 && len(current) > depth {
+		current = current[:depth]
+	}
+
+	if side == Sell {
+		b.Asks = current
+	} else {
+		b.Bids = current
+	}
+}
+
+// side returns the levels consumed by an order in the given direction.
+func (b *Book) side(direction OrderDirection) []BookLevel {
+	if direction == Sell {
+		return b.Bids
+	}
+	return b.Asks
+}
+
+// better returns true if price a comes before price b on the side of the orders in the given direction.
+func better(side OrderDirection, a, b Decimal) bool {
+	if side == Sell {
+		return a.Cmp(b) < 0
+	}
+	return a.Cmp(b) > 0
+}
+
+func (b *Book) sort() {
+	sort.SliceStable(b.Asks, func(i, j int) bool { return better(Sell, b.Asks[i].Price, b.Asks[j].Price) })
+	sort.SliceStable(b.Bids, func(i, j int) bool { return better(Buy, b.Bids[i].Price, b.Bids[j].Price) })
+}
+
+func bookLevels(entries []OrderBookEntries) ([]BookLevel, error) {
+	levels := make([]BookLevel, 0, len(entries))
+	for i, e := range entries {
+		l, err := e.BookLevel()
+		if err != nil {
+			return nil, fmt.Errorf("entry %d: %w", i, err)
+		}
+		levels = append(levels, l)
+	}
+	return levels, nil
+}
+
+func decimalValue(v any) (Decimal, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("expected a string, got %T", v)
+	}
+	return ParseDecimal(s)
+}
+
+// timeValue parses a Unix timestamp in seconds, given as a number or as a string with a fraction,
+// e.g. 1688671659 or "1688671659.123456".
+func timeValue(v any) (time.Time, error) {
+	var secs float64
+
+	switch value := v.(type) {
+	case float64:
+		secs = value
+	case int64:
+		secs = float64(value)
+	case int:
+		secs = float64(value)
+	case string:
+		// Parsed without a float64 so that the fraction is kept exactly.
+		whole, frac, _ := strings.Cut(value, ".")
+		sec, err := strconv.ParseInt(whole, 10, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if len(frac) > 9 {
+			frac = frac[:9]
+		}
+		var nsec int64
+		if frac != "" {
+			if nsec, err = strconv.ParseInt(frac+strings.Repeat("0", 9-len(frac)), 10, 64); err != nil {
+				return time.Time{}, err
+			}
+		}
+		return time.Unix(sec, nsec), nil
+	default:
+		return time.Time{}, fmt.Errorf("expected a number, got %T", v)
+	}
+
+	whole, frac := math.Modf(secs)
+	return time.Unix(int64(whole), int64(math.Round(frac*1e6))*1e3), nil
+}
@@ -0,0 +1,237 @@
+package kraken
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testBook() *Book {
+	return NewBook(
+		[]BookLevel{
+			{Price: "30387.90000", Volume: "1.500"},
+			{Price: "30384.10000", Volume: "2.000"},
+			{Price: "30390.00000", Volume: "0.500"},
+		},
+		[]BookLevel{
+			{Price: "30290.00000", Volume: "1.000"},
+			{Price: "30297.00000", Volume: "1.000"},
+		},
+	)
+}
+
+func TestOrderBook_Book(t *testing.T) {
+	tests := []struct {
+		name    string
+		o       OrderBook
+		want    *Book
+		wantErr bool
+	}{
+		{
+			name: "rest order book",
+			o: OrderBook{
+				Asks: []OrderBookEntries{
+					{"30387.90000", "1.500", float64(1688671380)},
+					{"30384.10000", "2.059", float64(1688671659)},
+				},
+				Bids: []OrderBookEntries{
+					{"30297.00000", "1.115", float64(1688671636)},
+				},
+			},
+			want: &Book{
+				Asks: []BookLevel{
+					{Price: "30384.10000", Volume: "2.059", Time: time.Unix(1688671659, 0)},
+					{Price: "30387.90000", Volume: "1.500", Time: time.Unix(1688671380, 0)},
+				},
+				Bids: []BookLevel{
+					{Price: "30297.00000", Volume: "1.115", Time: time.Unix(1688671636, 0)},
+				},
+			},
+		},
+		{
+			name: "streamed timestamps",
+			o: OrderBook{
+				Bids: []OrderBookEntries{
+					{"30297.00000", "1.115", "1688671636.123456"},
+				},
+			},
+			want: &Book{
+				Bids: []BookLevel{
+					{Price: "30297.00000", Volume: "1.115", Time: time.Unix(1688671636, 123456000)},
+				},
+			},
+		},
+		{
+			name:    "missing timestamp",
+			o:       OrderBook{Asks: []OrderBookEntries{{"30387.90000", "1.500"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid price",
+			o:       OrderBook{Bids: []OrderBookEntries{{30297.0, "1.115", float64(1688671636)}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid volume",
+			o:       OrderBook{Bids: []OrderBookEntries{{"30297.00000", "one", float64(1688671636)}}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.o.Book()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("OrderBook.Book() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OrderBook.Book() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBook_prices(t *testing.T) {
+	b := testBook()
+
+	if ask, _ := b.BestAsk(); ask.Price != "30384.10000" {
+		t.Errorf("Book.BestAsk() = %v, want %v", ask.Price, "30384.10000")
+	}
+	if bid, _ := b.BestBid(); bid.Price != "30297.00000" {
+		t.Errorf("Book.BestBid() = %v, want %v", bid.Price, "30297.00000")
+	}
+	if mid, _ := b.Mid(); !mid.Equal("30340.55") {
+		t.Errorf("Book.Mid() = %v, want %v", mid, "30340.55")
+	}
+	if spread, _ := b.Spread(); !spread.Equal("87.1") {
+		t.Errorf("Book.Spread() = %v, want %v", spread, "87.1")
+	}
+
+	empty := NewBook(nil, nil)
+	if _, ok := empty.Mid(); ok {
+		t.Errorf("Book.Mid() ok = true, want false for an empty book")
+	}
+	if _, ok := empty.Spread(); ok {
+		t.Errorf("Book.Spread() ok = true, want false for an empty book")
+	}
+}
+
+func TestBook_Depth(t *testing.T) {
+	got := testBook().Depth(Sell)
+
+	want := []struct {
+		volume, cost Decimal
+	}{
+		{volume: "1", cost: "30297"},
+		{volume: "2", cost: "60587"},
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("Book.Depth() = %v levels, want %v", len(got), len(want))
+	}
+	for i, w := range want {
+		if !got[i].CumulativeVolume.Equal(w.volume) || !got[i].CumulativeCost.Equal(w.cost) {
+			t.Errorf("Book.Depth()[%d] = %v, %v, want %v, %v", i, got[i].CumulativeVolume, got[i].CumulativeCost, w.volume, w.cost)
+		}
+	}
+}
+
+func TestBook_Fill(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction OrderDirection
+		volume    Decimal
+		wantAvg   Decimal
+		wantWorst Decimal
+		wantSlip  Decimal
+		wantErr   error
+	}{
+		{
+			name:      "within best level",
+			direction: Buy,
+			volume:    "1",
+			wantAvg:   "30384.1",
+			wantWorst: "30384.10000",
+			wantSlip:  "0",
+		},
+		{
+			name:      "walk the asks",
+			direction: Buy,
+			volume:    "3",
+			wantAvg:   "30385.3666666667",
+			wantWorst: "30387.90000",
+			wantSlip:  "0.0000416885",
+		},
+		{
+			name:      "walk the bids",
+			direction: Sell,
+			volume:    "2",
+			wantAvg:   "30293.5",
+			wantWorst: "30290.00000",
+			wantSlip:  "0.0001155230",
+		},
+		{
+			name:      "insufficient liquidity",
+			direction: Sell,
+			volume:    "5",
+			wantErr:   ErrInsufficientLiquidity,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := testBook()
+
+			f, err := b.Fill(tt.direction, tt.volume)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Book.Fill() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			if !f.AveragePrice.Equal(tt.wantAvg) || f.WorstPrice != tt.wantWorst {
+				t.Errorf("Book.Fill() = %v, want average %v and worst %v", f, tt.wantAvg, tt.wantWorst)
+			}
+
+			if vwap, _ := b.VWAP(tt.direction, tt.volume); vwap != f.AveragePrice {
+				t.Errorf("Book.VWAP() = %v, want %v", vwap, f.AveragePrice)
+			}
+
+			if slip, _ := b.Slippage(tt.direction, tt.volume); !slip.Equal(tt.wantSlip) {
+				t.Errorf("Book.Slippage() = %v, want %v", slip, tt.wantSlip)
+			}
+		})
+	}
+}
+
+func TestBook_Update(t *testing.T) {
+	b := testBook()
+
+	b.Update(Sell, 3,
+		BookLevel{Price: "30384.10000", Volume: "0.00000000"}, // Remove the best ask.
+		BookLevel{Price: "30387.9", Volume: "3.000"},          // Replace a level.
+		BookLevel{Price: "30380.00000", Volume: "1.000"},      // New best ask.
+		BookLevel{Price: "30400.00000", Volume: "1.000"},      // Out of the subscribed depth.
+		BookLevel{Price: "30500.00000", Volume: "0"},          // Unknown level.
+	)
+	b.Update(Buy, 0, BookLevel{Price: "30295.00000", Volume: "2.000"})
+
+	wantAsks := []Decimal{"30380.00000", "30387.9", "30390.00000"}
+	wantBids := []Decimal{"30297.00000", "30295.00000", "30290.00000"}
+
+	if got := prices(b.Asks); !reflect.DeepEqual(got, wantAsks) {
+		t.Errorf("Book.Asks = %v, want %v", got, wantAsks)
+	}
+	if got := prices(b.Bids); !reflect.DeepEqual(got, wantBids) {
+		t.Errorf("Book.Bids = %v, want %v", got, wantBids)
+	}
+}
+
+func prices(levels []BookLevel) []Decimal {
+	p := make([]Decimal, len(levels))
+	for i, l := range levels {
+		p[i] = l.Price
+	}
+	return p
+}
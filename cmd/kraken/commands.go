@@ -0,0 +1,377 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+func runTime(ctx context.Context, a *app, args []string) error {
+	if _, err := a.parse(a.flags(a.name), args); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Market.Time(ctx)
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"UNIXTIME", "RFC1123"}}
+	t.add(strconv.FormatInt(v.UnixTime, 10), v.Rfc1123)
+
+	return a.print(v, t)
+}
+
+func runStatus(ctx context.Context, a *app, args []string) error {
+	if _, err := a.parse(a.flags(a.name), args); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Market.SystemStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"STATUS", "TIMESTAMP"}}
+	t.add(string(v.Status), v.Timestamp)
+
+	return a.print(v, t)
+}
+
+func runTicker(ctx context.Context, a *app, args []string) error {
+	pairs, err := a.parse(a.flags(a.name), args)
+	if err != nil {
+		return err
+	}
+	if len(pairs) == 0 {
+		return errors.New("at least one pair is required")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	opts := kraken.TickerInformationOpts{}
+	for _, p := range pairs {
+		opts.Pairs = append(opts.Pairs, kraken.AssetPair(p))
+	}
+
+	v, err := c.Market.TickerInformation(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"PAIR", "ASK", "BID", "LAST", "VOLUME_24H", "LOW_24H", "HIGH_24H"}}
+	for _, pair := range sortedKeys(v) {
+		info := v[pair]
+		t.add(string(pair), at(info.Ask, 0), at(info.Bid, 0), at(info.Last, 0), at(info.Volume, 1), at(info.Low, 1), at(info.High, 1))
+	}
+
+	return a.print(v, t)
+}
+
+func runBook(ctx context.Context, a *app, args []string) error {
+	fs := a.flags(a.name)
+	depth := fs.Int("depth", 10, "number of asks and bids, up to 500")
+
+	pairs, err := a.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(pairs) != 1 {
+		return errors.New("a single pair is required")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Market.OrderBook(ctx, kraken.OrderBookOpts{Pair: kraken.AssetPair(pairs[0]), Count: *depth})
+	if err != nil {
+		return err
+	}
+
+	b, err := v.Book()
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"SIDE", "PRICE", "VOLUME", "TIME"}}
+	for i := len(b.Asks) - 1; i >= 0; i-- {
+		t.add("ask", string(b.Asks[i].Price), string(b.Asks[i].Volume), formatTime(b.Asks[i].Time))
+	}
+	for _, l := range b.Bids {
+		t.add("bid", string(l.Price), string(l.Volume), formatTime(l.Time))
+	}
+
+	return a.print(v, t)
+}
+
+func runOHLC(ctx context.Context, a *app, args []string) error {
+	fs := a.flags(a.name)
+	interval := fs.Int("interval", 1, "time frame interval in minutes")
+	since := fs.Int("since", 0, "return the data since the given ID")
+
+	pairs, err := a.parse(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(pairs) != 1 {
+		return errors.New("a single pair is required")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Market.OHCLData(ctx, kraken.OHCLDataOpts{Pair: kraken.AssetPair(pairs[0]), Interval: *interval, Since: *since})
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"TIME", "OPEN", "HIGH", "LOW", "CLOSE", "VWAP", "VOLUME", "COUNT"}}
+	for _, values := range v.Pair {
+		tick := values.Ticker()
+		t.add(formatTime(time.Unix(tick.Time, 0)), string(tick.Open), string(tick.High), string(tick.Low),
+			string(tick.Close), string(tick.Vwap), string(tick.Volume), strconv.FormatInt(tick.Count, 10))
+	}
+
+	return a.print(v, t)
+}
+
+func runBalance(ctx context.Context, a *app, args []string) error {
+	if _, err := a.parse(a.flags(a.name), args); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Account.Balance(ctx)
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"ASSET", "BALANCE"}}
+	for _, asset := range sortedKeys(v) {
+		t.add(string(asset), string(v[asset]))
+	}
+
+	return a.print(v, t)
+}
+
+func runOpenOrders(ctx context.Context, a *app, args []string) error {
+	fs := a.flags(a.name)
+	var opts kraken.OpenOrdersOpts
+	fs.StringVar(&opts.UserRef, "userref", "", "only the orders with the user reference")
+	fs.StringVar(&opts.ClientOrderID, "cl-ord-id", "", "only the order with the client order ID")
+
+	if _, err := a.parse(fs, args); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Trading.OpenOrders(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"TXID", "PAIR", "TYPE", "ORDERTYPE", "PRICE", "PRICE2", "VOLUME", "EXECUTED", "STATUS", "OPENED"}}
+	for _, txid := range sortedKeys(v.Open) {
+		o := v.Open[txid]
+		d := o.Description
+		t.add(string(txid), d.AssetPair, d.Type, d.OrderType, d.PrimaryPrice, d.SecondaryPrice,
+			string(o.Volume), string(o.VolumeExecuted), string(o.Status), formatTime(unixTime(o.OpenTime)))
+	}
+
+	return a.print(v, t)
+}
+
+func runAddOrder(ctx context.Context, a *app, args []string) error {
+	fs := a.flags(a.name)
+
+	var opts kraken.AddOrderOpts
+	fs.StringVar(&opts.Pair, "pair", "", "asset pair, e.g. XBTUSD")
+	direction := fs.String("type", "", "order direction: buy or sell")
+	orderType := fs.String("ordertype", string(kraken.Limit), "order type, e.g. market, limit or stop-loss")
+	volume := fs.String("volume", "", "order volume in the base asset")
+	price := fs.String("price", "", "limit or trigger price, or an offset such as +1.5 or -2%")
+	price2 := fs.String("price2", "", "limit price of stop-loss-limit and take-profit-limit orders")
+	flags := fs.String("oflags", "", "comma separated order flags, e.g. post,fciq")
+	tif := fs.String("timeinforce", "", "time in force: GTC, IOC or GTD")
+	fs.StringVar(&opts.UserRef, "userref", "", "user reference of the order")
+	fs.StringVar(&opts.ClientOrderID, "cl-ord-id", "", "client order ID")
+	fs.BoolVar(&opts.Validate, "validate", false, "validate the order without placing it")
+
+	if _, err := a.parse(fs, args); err != nil {
+		return err
+	}
+	if opts.Pair == "" || *direction == "" || *volume == "" {
+		return errors.New("--pair, --type and --volume are required")
+	}
+
+	opts.Type = kraken.OrderDirection(*direction)
+	opts.OrderType = kraken.OrderType(*orderType)
+	opts.Volume = kraken.Decimal(*volume)
+	opts.Price = kraken.OrderPrice(*price)
+	opts.Price2 = kraken.OrderPrice(*price2)
+	opts.TimeInForce = kraken.TimeInForce(*tif)
+	for _, f := range strings.Split(*flags, ",") {
+		if f != "" {
+			opts.OrderFlags = append(opts.OrderFlags, kraken.OrderFlag(f))
+		}
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Trading.AddOrder(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"TXID", "DESCRIPTION"}}
+	if len(v.Transaction) == 0 {
+		t.add("", v.Description.Order)
+	}
+	for _, txid := range v.Transaction {
+		t.add(string(txid), v.Description.Order)
+	}
+
+	return a.print(v, t)
+}
+
+func runCancelOrder(ctx context.Context, a *app, args []string) error {
+	txids, err := a.parse(a.flags(a.name), args)
+	if err != nil {
+		return err
+	}
+	if len(txids) != 1 {
+		return errors.New("a single transaction ID is required")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Trading.CancelOrder(ctx, kraken.CancelOrderOpts{TransactionID: txids[0]})
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"COUNT"}}
+	t.add(strconv.Itoa(v.Count))
+
+	return a.print(v, t)
+}
+
+func runStrategies(ctx context.Context, a *app, args []string) error {
+	fs := a.flags(a.name)
+	asset := fs.String("asset", "", "only the strategies of the asset")
+	lockType := fs.String("lock-type", "", "only the strategies of the lock type: flex, bounded, timed or instant")
+	limit := fs.Int("limit", 0, "maximum number of strategies")
+
+	if _, err := a.parse(fs, args); err != nil {
+		return err
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Earn.Strategies(ctx, kraken.StrategiesOpts{
+		Asset:    kraken.Asset(*asset),
+		LockType: kraken.StrategyLockType(*lockType),
+		Limit:    *limit,
+	})
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"ID", "ASSET", "LOCK_TYPE", "APR_LOW", "APR_HIGH", "CAN_ALLOCATE"}}
+	for _, s := range v.Items {
+		t.add(s.ID, string(s.Asset), string(s.LockType.Type), string(s.AprEstimate.Low), string(s.AprEstimate.High), strconv.FormatBool(s.CanAllocate))
+	}
+
+	return a.print(v, t)
+}
+
+func runTransfer(ctx context.Context, a *app, args []string) error {
+	fs := a.flags(a.name)
+
+	var opts kraken.TransferOpts
+	fs.StringVar(&opts.Asset, "asset", "", "asset to transfer")
+	amount := fs.String("amount", "", "amount to transfer")
+	fs.StringVar(&opts.From, "from", "", "IIBAN of the source account")
+	fs.StringVar(&opts.To, "to", "", "IIBAN of the destination account")
+
+	if _, err := a.parse(fs, args); err != nil {
+		return err
+	}
+	opts.Amount = kraken.Decimal(*amount)
+
+	if !opts.Valid() {
+		return errors.New("--asset, --amount, --from and --to are required")
+	}
+
+	c, err := a.client()
+	if err != nil {
+		return err
+	}
+
+	v, err := c.Subaccounts.Transfer(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	t := table{header: []string{"TRANSFER_ID", "STATUS"}}
+	t.add(v.TransferID, string(v.Status))
+
+	return a.print(v, t)
+}
+
+// at returns the value at index i, or an empty string if missing.
+func at(values []kraken.Decimal, i int) string {
+	if i >= len(values) {
+		return ""
+	}
+	return string(values[i])
+}
+
+func unixTime(t float64) time.Time {
+	return time.Unix(0, int64(t*float64(time.Second)))
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() || t.Unix() == 0 {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
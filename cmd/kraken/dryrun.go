@@ -0,0 +1,51 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+
+	"github.com/jferrl/go-kraken"
+)
+
+// errDryRun stops the requests printed by a dryRunTransport.
+var errDryRun = errors.New("dry run")
+
+// dryRunTransport is an http.RoundTripper that prints the signed requests instead of sending them.
+// The API key and the signature are redacted, so that the output can be shared.
+type dryRunTransport struct {
+	w io.Writer
+}
+
+func (t dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fmt.Fprintf(t.w, "%s %s\n", req.Method, req.URL)
+
+	header := kraken.RedactHeader(req.Header)
+
+	names := make([]string, 0, len(header))
+	for name := range header {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, v := range header[name] {
+			fmt.Fprintf(t.w, "%s: %s\n", name, v)
+		}
+	}
+
+	if req.Body != nil {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > 0 {
+			fmt.Fprintf(t.w, "\n%s\n", body)
+		}
+	}
+
+	return nil, errDryRun
+}
@@ -0,0 +1,180 @@
+// Command kraken runs everyday account and market tasks against the Kraken REST API, e.g.
+//
+//	kraken time
+//	kraken status
+//	kraken ticker XBTUSD ETHUSD
+//	kraken book XBTUSD --depth 10
+//	kraken ohlc XBTUSD --interval 60
+//	kraken balance
+//	kraken orders open
+//	kraken order add --pair XBTUSD --type buy --ordertype limit --price 30000 --volume 0.01 --validate
+//	kraken order cancel OUF4EM-FRGI2-MQMWZD
+//	kraken earn strategies --asset DOT
+//	kraken subaccount transfer --asset XBT --amount 0.1 --from <IIBAN> --to <IIBAN>
+//
+// Private commands are authenticated with the API key and secret set in the KRAKEN_API_KEY
+// and KRAKEN_API_SECRET environment variables.
+//
+// Results are printed as a table by default, or as JSON or CSV with --format. With --dry-run,
+// requests are signed and printed instead of being sent, with the API key and signature redacted.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+
+	"github.com/jferrl/go-kraken"
+)
+
+// command is a subcommand of the tool, e.g. "order add".
+type command struct {
+	name  string
+	usage string
+	run   func(ctx context.Context, a *app, args []string) error
+}
+
+var commands = []command{
+	{name: "time", usage: "print the server time", run: runTime},
+	{name: "status", usage: "print the system status", run: runStatus},
+	{name: "ticker", usage: "PAIR... print the ticker of the pairs", run: runTicker},
+	{name: "book", usage: "PAIR [--depth N] print the order book of the pair", run: runBook},
+	{name: "ohlc", usage: "PAIR [--interval MINUTES] [--since ID] print the OHLC data of the pair", run: runOHLC},
+	{name: "balance", usage: "print the account balance", run: runBalance},
+	{name: "orders open", usage: "[--userref REF] [--cl-ord-id ID] print the open orders", run: runOpenOrders},
+	{name: "order add", usage: "--pair PAIR --type buy|sell --volume VOLUME [--price PRICE] [--validate] place an order", run: runAddOrder},
+	{name: "order cancel", usage: "TXID cancel an order", run: runCancelOrder},
+	{name: "earn strategies", usage: "[--asset ASSET] [--lock-type TYPE] print the earn strategies", run: runStrategies},
+	{name: "subaccount transfer", usage: "--asset ASSET --amount AMOUNT --from IIBAN --to IIBAN transfer funds", run: runTransfer},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	err := run(ctx, os.Args[1:], os.Stdout, os.Stderr)
+	switch {
+	case errors.Is(err, flag.ErrHelp):
+		os.Exit(2)
+	case err != nil:
+		fmt.Fprintf(os.Stderr, "kraken: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run runs the command of the arguments, writing its result to stdout.
+func run(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	for _, cmd := range commands {
+		words := strings.Fields(cmd.name)
+		if len(args) < len(words) || strings.Join(args[:len(words)], " ") != cmd.name {
+			continue
+		}
+
+		a := &app{name: cmd.name, stdout: stdout, stderr: stderr}
+
+		err := cmd.run(ctx, a, args[len(words):])
+		if errors.Is(err, errDryRun) {
+			return nil
+		}
+		return err
+	}
+
+	usage(stderr)
+	return flag.ErrHelp
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: kraken <command> [flags]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-20s %s\n", cmd.name, cmd.usage)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Flags of every command:")
+	(&app{stderr: w}).flags("").PrintDefaults()
+}
+
+// app holds the flags shared by every command.
+type app struct {
+	name           string
+	stdout, stderr io.Writer
+
+	format  string
+	dryRun  bool
+	baseURL string
+}
+
+// flags returns the flag set of the command, with the flags shared by every command.
+func (a *app) flags(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("kraken "+name, flag.ContinueOnError)
+	fs.SetOutput(a.stderr)
+
+	fs.StringVar(&a.format, "format", formatTable, "output format: table, json or csv")
+	fs.BoolVar(&a.dryRun, "dry-run", false, "sign and print the requests instead of sending them")
+	fs.StringVar(&a.baseURL, "url", "", "base URL of the API, including the version, e.g. https://api.kraken.com/0/")
+
+	return fs
+}
+
+// parse parses the flags of the command, which can be interleaved with its positional
+// arguments, e.g. "book XBTUSD --depth 10", and returns the positional arguments.
+func (a *app) parse(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		positional = append(positional, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+
+	switch a.format {
+	case formatTable, formatJSON, formatCSV:
+	default:
+		return nil, fmt.Errorf("unknown format %q", a.format)
+	}
+
+	return positional, nil
+}
+
+// client returns the client of the API, authenticated with the credentials set in the environment.
+func (a *app) client() (*kraken.Client, error) {
+	httpClient := &http.Client{}
+	if a.dryRun {
+		httpClient.Transport = dryRunTransport{w: a.stdout}
+	}
+
+	c := kraken.New(httpClient).WithCredentials(kraken.EnvCredentials{})
+
+	if a.baseURL != "" {
+		u, err := url.Parse(a.baseURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid url: %w", err)
+		}
+		c.WithBaseURL(u)
+	}
+
+	return c, nil
+}
+
+// sortedKeys returns the keys of the map in ascending order.
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	return keys
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jferrl/go-kraken"
+	"github.com/jferrl/go-kraken/krakentest"
+)
+
+func testServer(t *testing.T) *krakentest.Server {
+	t.Helper()
+
+	srv := krakentest.NewServer(krakentest.Config{
+		Balances: kraken.AccountBalance{"XXBT": "1", "ZUSD": "100000"},
+	})
+	t.Cleanup(srv.Close)
+
+	book := kraken.NewBook(
+		[]kraken.BookLevel{{Price: "30000.0", Volume: "1"}, {Price: "30010.0", Volume: "2"}},
+		[]kraken.BookLevel{{Price: "29990.0", Volume: "1"}, {Price: "29980.0", Volume: "2"}},
+	)
+	if err := srv.Exchange().SetBook(kraken.XXBTZUSD, book); err != nil {
+		t.Fatalf("Exchange.SetBook() error = %v", err)
+	}
+
+	secrets := srv.NewKey()
+	t.Setenv(kraken.DefaultKeyEnv, secrets.Key)
+	t.Setenv(kraken.DefaultSecretEnv, secrets.Secret)
+
+	return srv
+}
+
+func runCommand(t *testing.T, srv *krakentest.Server, args ...string) (string, error) {
+	t.Helper()
+
+	var stdout, stderr bytes.Buffer
+	err := run(context.Background(), append(args, "--url", srv.BaseURL().String()), &stdout, &stderr)
+
+	return stdout.String(), err
+}
+
+func Test_run(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "balance table",
+			args: []string{"balance"},
+			want: "ASSET  BALANCE\nXXBT   1\nZUSD   100000\n",
+		},
+		{
+			name: "balance csv",
+			args: []string{"balance", "--format", "csv"},
+			want: "ASSET,BALANCE\nXXBT,1\nZUSD,100000\n",
+		},
+		{
+			name: "ticker with interleaved flags",
+			args: []string{"ticker", "--format", "csv", "XBTUSD"},
+			want: "PAIR,ASK,BID,LAST,VOLUME_24H,LOW_24H,HIGH_24H\nXXBTZUSD,30000.0,29990.0,,,,\n",
+		},
+		{
+			name: "validated order",
+			args: []string{"order", "add", "--pair", "XBTUSD", "--type", "buy", "--price", "29000", "--volume", "0.1", "--validate", "--format", "csv"},
+			want: "TXID,DESCRIPTION\n,buy 0.1 XBTUSD @ limit 29000\n",
+		},
+		{
+			name:    "order without volume",
+			args:    []string{"order", "add", "--pair", "XBTUSD", "--type", "buy"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown format",
+			args:    []string{"time", "--format", "xml"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown flag",
+			args:    []string{"time", "--depth", "1"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := testServer(t)
+
+			got, err := runCommand(t, srv, tt.args...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("run() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("run() output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_run_orders(t *testing.T) {
+	srv := testServer(t)
+
+	if _, err := runCommand(t, srv, "order", "add", "--pair", "XBTUSD", "--type", "buy", "--price", "29000", "--volume", "0.1"); err != nil {
+		t.Fatalf("order add error = %v", err)
+	}
+
+	got, err := runCommand(t, srv, "orders", "open", "--format", "json")
+	if err != nil {
+		t.Fatalf("orders open error = %v", err)
+	}
+
+	var open kraken.OpenOrders
+	if err := json.Unmarshal([]byte(got), &open); err != nil {
+		t.Fatalf("decoding orders open output %q: %v", got, err)
+	}
+	if o, ok := open.Open["OPAPER-000001"]; !ok || o.Description.PrimaryPrice != "29000" {
+		t.Errorf("orders open = %+v, want the limit order", open.Open)
+	}
+
+	got, err = runCommand(t, srv, "order", "cancel", "OPAPER-000001", "--format", "csv")
+	if err != nil {
+		t.Fatalf("order cancel error = %v", err)
+	}
+	if want := "COUNT\n1\n"; got != want {
+		t.Errorf("order cancel output = %q, want %q", got, want)
+	}
+}
+
+func Test_run_dryRun(t *testing.T) {
+	srv := testServer(t)
+
+	got, err := runCommand(t, srv, "balance", "--dry-run")
+	if err != nil {
+		t.Fatalf("run() error = %v", err)
+	}
+
+	for _, want := range []string{"POST " + srv.URL + "/0/private/Balance\n", "Api-Key: REDACTED\n", "Api-Sign: REDACTED\n", "\nnonce="} {
+		if !strings.Contains(got, want) {
+			t.Errorf("run() output = %q, want %q", got, want)
+		}
+	}
+	if key := os.Getenv(kraken.DefaultKeyEnv); strings.Contains(got, key) {
+		t.Errorf("run() output = %q, leaks the API key", got)
+	}
+
+	if calls := srv.Calls("Balance"); calls != 0 {
+		t.Errorf("Balance calls = %d, want none", calls)
+	}
+}
+
+func Test_run_usage(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+
+	err := run(context.Background(), []string{"orders"}, &stdout, &stderr)
+	if !errors.Is(err, flag.ErrHelp) {
+		t.Errorf("run() error = %v, want %v", err, flag.ErrHelp)
+	}
+	if !strings.Contains(stderr.String(), "orders open") {
+		t.Errorf("usage = %q, want the commands", stderr.String())
+	}
+}
+
+func Test_app_parse(t *testing.T) {
+	a := &app{stderr: &bytes.Buffer{}}
+	fs := a.flags("book")
+	depth := fs.Int("depth", 10, "")
+
+	got, err := a.parse(fs, []string{"XBTUSD", "--depth", "5", "ETHUSD", "--format", "json"})
+	if err != nil {
+		t.Fatalf("app.parse() error = %v", err)
+	}
+
+	if want := []string{"XBTUSD", "ETHUSD"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("app.parse() = %v, want %v", got, want)
+	}
+	if *depth != 5 || a.format != formatJSON {
+		t.Errorf("flags = %d, %s, want 5, json", *depth, a.format)
+	}
+}
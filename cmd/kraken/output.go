@@ -0,0 +1,51 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+	formatCSV   = "csv"
+)
+
+// table is the tabular representation of a result, printed in the table and CSV formats.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+func (t *table) add(row ...string) {
+	t.rows = append(t.rows, row)
+}
+
+// print writes the result in the format of the command: v as JSON, or its table.
+func (a *app) print(v any, t table) error {
+	switch a.format {
+	case formatJSON:
+		enc := json.NewEncoder(a.stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	case formatCSV:
+		w := csv.NewWriter(a.stdout)
+		if err := w.Write(t.header); err != nil {
+			return err
+		}
+		if err := w.WriteAll(t.rows); err != nil {
+			return err
+		}
+		return w.Error()
+	default:
+		w := tabwriter.NewWriter(a.stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, strings.Join(t.header, "\t"))
+		for _, row := range t.rows {
+			fmt.Fprintln(w, strings.Join(row, "\t"))
+		}
+		return w.Flush()
+	}
+}
@@ -0,0 +1,289 @@
+package kraken
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CredentialsProvider provides the Kraken API key and secret used to sign private requests.
+type CredentialsProvider interface {
+	Credentials(ctx context.Context) (Secrets, error)
+}
+
+// Credentials returns the secrets themselves, so that Secrets can be used as a static CredentialsProvider.
+func (s Secrets) Credentials(_ context.Context) (Secrets, error) {
+	return s, nil
+}
+
+// valid returns an error if the key or the secret is missing.
+func (s Secrets) valid() error {
+	if s.Key == "" || s.Secret == "" {
+		return errors.New("API key and secret are required")
+	}
+	return nil
+}
+
+const (
+	// DefaultKeyEnv is the default environment variable holding the API key.
+	DefaultKeyEnv = "KRAKEN_API_KEY"
+	// DefaultSecretEnv is the default environment variable holding the API secret.
+	DefaultSecretEnv = "KRAKEN_API_SECRET"
+)
+
+// EnvCredentials loads the API key and secret from environment variables.
+// The zero value uses DefaultKeyEnv and DefaultSecretEnv.
+type EnvCredentials struct {
+	KeyEnv    string
+	SecretEnv string
+}
+
+// Credentials returns the API key and secret set in the environment.
+func (e EnvCredentials) Credentials(_ context.Context) (Secrets, error) {
+	keyEnv, secretEnv := e.KeyEnv, e.SecretEnv
+	if keyEnv == "" {
+		keyEnv = DefaultKeyEnv
+	}
+	if secretEnv == "" {
+		secretEnv = DefaultSecretEnv
+	}
+
+	s := Secrets{
+		Key:    os.Getenv(keyEnv),
+		Secret: os.Getenv(secretEnv),
+	}
+	if err := s.valid(); err != nil {
+		return Secrets{}, fmt.Errorf("%w: set %s and %s", err, keyEnv, secretEnv)
+	}
+
+	return s, nil
+}
+
+// FileCredentials loads the API key and secret from a JSON or YAML file,
+// depending on its extension:
+//
+//	{"key": "<API key>", "secret": "<API secret>"}
+//
+//	key: <API key>
+//	secret: <API secret>
+//
+// Unless AllowInsecurePermissions is set, the file must not be accessible
+// by the group or other users.
+type FileCredentials struct {
+	Path                     string
+	AllowInsecurePermissions bool
+}
+
+// Credentials reads the API key and secret from the file.
+func (f FileCredentials) Credentials(_ context.Context) (Secrets, error) {
+	info, err := os.Stat(f.Path)
+	if err != nil {
+		return Secrets{}, err
+	}
+
+	// Unix permissions are not meaningful on Windows.
+	if !f.AllowInsecurePermissions && runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return Secrets{}, fmt.Errorf("credentials file %s is accessible by other users (mode %v), it should be 0600", f.Path, info.Mode().Perm())
+	}
+
+	b, err := os.ReadFile(f.Path)
+	if err != nil {
+		return Secrets{}, err
+	}
+
+	var s Secrets
+
+	switch strings.ToLower(filepath.Ext(f.Path)) {
+	case ".json":
+		err = json.Unmarshal(b, &s)
+	case ".yaml", ".yml":
+		s, err = parseYAMLCredentials(b)
+	default:
+		err = errors.New("unsupported format, use a .json, .yaml or .yml file")
+	}
+	if err != nil {
+		return Secrets{}, fmt.Errorf("reading credentials file %s: %w", f.Path, err)
+	}
+
+	if err := s.valid(); err != nil {
+		return Secrets{}, fmt.Errorf("reading credentials file %s: %w", f.Path, err)
+	}
+
+	return s, nil
+}
+
+// parseYAMLCredentials parses the flat "key" and "secret" mapping of a YAML credentials file.
+func parseYAMLCredentials(b []byte) (Secrets, error) {
+	var s Secrets
+
+	for i, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || line == "---" {
+			continue
+		}
+
+		name, value, found := strings.Cut(line, ":")
+		if !found {
+			return Secrets{}, fmt.Errorf("line %d: expected <name>: <value>", i+1)
+		}
+
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		} else if v, _, found := strings.Cut(value, " #"); found {
+			value = strings.TrimSpace(v)
+		}
+
+		switch strings.TrimSpace(name) {
+		case "key":
+			s.Key = value
+		case "secret":
+			s.Secret = value
+		}
+	}
+
+	return s, nil
+}
+
+// RefreshingCredentials caches the credentials of a provider and reloads them
+// once the refresh interval has elapsed, so that keys can be rotated without
+// recreating the Client. It is safe for concurrent use.
+type RefreshingCredentials struct {
+	provider CredentialsProvider
+	interval time.Duration
+
+	mu      sync.Mutex
+	current Secrets
+	expires time.Time
+
+	now func() time.Time
+}
+
+// NewRefreshingCredentials returns a new RefreshingCredentials that reloads
+// the credentials of the provider every interval.
+func NewRefreshingCredentials(p CredentialsProvider, interval time.Duration) *RefreshingCredentials {
+	return &RefreshingCredentials{
+		provider: p,
+		interval: interval,
+		now:      time.Now,
+	}
+}
+
+// Credentials returns the cached credentials, reloading them if they expired.
+func (r *RefreshingCredentials) Credentials(ctx context.Context) (Secrets, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.now().Before(r.expires) {
+		return r.current, nil
+	}
+
+	return r.refresh(ctx)
+}
+
+// Refresh reloads the credentials immediately.
+func (r *RefreshingCredentials) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, err := r.refresh(ctx)
+	return err
+}
+
+func (r *RefreshingCredentials) refresh(ctx context.Context) (Secrets, error) {
+	s, err := r.provider.Credentials(ctx)
+	if err != nil {
+		return Secrets{}, err
+	}
+
+	r.current = s
+	r.expires = r.now().Add(r.interval)
+
+	return s, nil
+}
+
+// keySigner signs private requests on behalf of an API key.
+type keySigner interface {
+	signRequest(ctx context.Context, path, nonce string, body []byte) (APIKey, string, error)
+}
+
+// staticKeySigner signs requests with a fixed API key and signer.
+type staticKeySigner struct {
+	key    APIKey
+	signer RequestSigner
+	err    error // Error found while decoding the secret.
+}
+
+func (s staticKeySigner) signRequest(ctx context.Context, path, nonce string, body []byte) (APIKey, string, error) {
+	if s.err != nil {
+		return "", "", s.err
+	}
+
+	signature, err := s.signer.SignRequest(ctx, path, nonce, body)
+	if err != nil {
+		return "", "", fmt.Errorf("signing request: %w", err)
+	}
+
+	return s.key, signature, nil
+}
+
+// providerKeySigner signs requests with the credentials of a provider.
+// When the provider returns new credentials, the decoded secret of the previous ones is zeroed.
+type providerKeySigner struct {
+	provider CredentialsProvider
+
+	mu      sync.RWMutex
+	current Secrets
+	signer  Signer
+}
+
+func (p *providerKeySigner) signRequest(ctx context.Context, path, nonce string, body []byte) (APIKey, string, error) {
+	s, err := p.provider.Credentials(ctx)
+	if err != nil {
+		return "", "", fmt.Errorf("loading credentials: %w", err)
+	}
+
+	if err := p.rotate(s); err != nil {
+		return "", "", err
+	}
+
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	signature, _ := p.signer.SignRequest(ctx, path, nonce, body)
+	return APIKey(p.current.Key), signature, nil
+}
+
+// rotate replaces the signer if the credentials changed.
+func (p *providerKeySigner) rotate(s Secrets) error {
+	p.mu.RLock()
+	unchanged := p.current == s && p.signer.Secret != nil
+	p.mu.RUnlock()
+
+	if unchanged {
+		return nil
+	}
+
+	signer, err := NewSigner(s.Secret)
+	if err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	old := p.signer
+	p.current = s
+	p.signer = signer
+	p.mu.Unlock()
+
+	// No request holds the old signer anymore.
+	old.Secret.Zero()
+
+	return nil
+}
@@ -0,0 +1,212 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEnvCredentials_Credentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		creds   EnvCredentials
+		want    Secrets
+		wantErr bool
+	}{
+		{
+			name: "default variables",
+			env:  map[string]string{DefaultKeyEnv: "key", DefaultSecretEnv: "secret"},
+			want: Secrets{Key: "key", Secret: "secret"},
+		},
+		{
+			name:  "custom variables",
+			env:   map[string]string{"TRADE_KEY": "key", "TRADE_SECRET": "secret"},
+			creds: EnvCredentials{KeyEnv: "TRADE_KEY", SecretEnv: "TRADE_SECRET"},
+			want:  Secrets{Key: "key", Secret: "secret"},
+		},
+		{
+			name:    "missing secret",
+			env:     map[string]string{DefaultKeyEnv: "key", DefaultSecretEnv: ""},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+
+			got, err := tt.creds.Credentials(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("EnvCredentials.Credentials() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnvCredentials.Credentials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFileCredentials_Credentials(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		perm     os.FileMode
+		insecure bool
+		want     Secrets
+		wantErr  bool
+	}{
+		{
+			name:    "json",
+			file:    "credentials.json",
+			content: `{"key": "key", "secret": "secret"}`,
+			perm:    0o600,
+			want:    Secrets{Key: "key", Secret: "secret"},
+		},
+		{
+			name:    "yaml",
+			file:    "credentials.yaml",
+			content: "# Kraken\nkey: key # trading key\nsecret: \"sec:ret\"\n",
+			perm:    0o600,
+			want:    Secrets{Key: "key", Secret: "sec:ret"},
+		},
+		{
+			name:    "insecure permissions",
+			file:    "credentials.json",
+			content: `{"key": "key", "secret": "secret"}`,
+			perm:    0o644,
+			wantErr: true,
+		},
+		{
+			name:     "allowed insecure permissions",
+			file:     "credentials.yml",
+			content:  "key: key\nsecret: secret",
+			perm:     0o644,
+			insecure: true,
+			want:     Secrets{Key: "key", Secret: "secret"},
+		},
+		{
+			name:    "missing secret",
+			file:    "credentials.json",
+			content: `{"key": "key"}`,
+			perm:    0o600,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported format",
+			file:    "credentials.txt",
+			content: "key secret",
+			perm:    0o600,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), tt.perm); err != nil {
+				t.Fatal(err)
+			}
+			_ = os.Chmod(path, tt.perm)
+
+			f := FileCredentials{Path: path, AllowInsecurePermissions: tt.insecure}
+
+			got, err := f.Credentials(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Errorf("FileCredentials.Credentials() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FileCredentials.Credentials() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type countingCredentials struct {
+	calls   int
+	secrets []Secrets
+}
+
+func (c *countingCredentials) Credentials(_ context.Context) (Secrets, error) {
+	if c.calls >= len(c.secrets) {
+		return Secrets{}, errors.New("no more credentials")
+	}
+
+	s := c.secrets[c.calls]
+	c.calls++
+	return s, nil
+}
+
+func TestRefreshingCredentials_Credentials(t *testing.T) {
+	ctx := context.Background()
+
+	clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+	p := &countingCredentials{secrets: []Secrets{{Key: "first"}, {Key: "second"}, {Key: "third"}}}
+
+	r := NewRefreshingCredentials(p, time.Minute)
+	r.now = clock.now
+
+	for _, want := range []string{"first", "first"} {
+		if got, _ := r.Credentials(ctx); got.Key != want {
+			t.Errorf("RefreshingCredentials.Credentials() = %v, want %v", got.Key, want)
+		}
+	}
+
+	clock.advance(time.Minute)
+
+	if got, _ := r.Credentials(ctx); got.Key != "second" {
+		t.Errorf("RefreshingCredentials.Credentials() = %v, want %v", got.Key, "second")
+	}
+
+	if err := r.Refresh(ctx); err != nil {
+		t.Fatalf("RefreshingCredentials.Refresh() error = %v", err)
+	}
+	if got, _ := r.Credentials(ctx); got.Key != "third" {
+		t.Errorf("RefreshingCredentials.Credentials() = %v, want %v", got.Key, "third")
+	}
+}
+
+func Test_providerKeySigner_rotate(t *testing.T) {
+	ctx := context.Background()
+
+	p := &countingCredentials{secrets: []Secrets{
+		{Key: "first", Secret: "c2VjcmV0"},
+		{Key: "first", Secret: "c2VjcmV0"},
+		{Key: "second", Secret: "b3RoZXI="},
+	}}
+
+	s := &providerKeySigner{provider: p}
+
+	key, _, err := s.signRequest(ctx, "/0/private/Balance", "1", nil)
+	if err != nil || key != "first" {
+		t.Fatalf("providerKeySigner.signRequest() = %v, %v, want %v", key, err, "first")
+	}
+
+	first := s.signer.Secret
+
+	if key, _, _ := s.signRequest(ctx, "/0/private/Balance", "2", nil); key != "first" {
+		t.Errorf("providerKeySigner.signRequest() = %v, want %v", key, "first")
+	}
+	if string(first) != "secret" {
+		t.Errorf("secret = %v, must not be zeroed while in use", first)
+	}
+
+	if key, _, _ := s.signRequest(ctx, "/0/private/Balance", "3", nil); key != "second" {
+		t.Errorf("providerKeySigner.signRequest() = %v, want %v", key, "second")
+	}
+	if !reflect.DeepEqual(first, Secret(make([]byte, len("secret")))) {
+		t.Errorf("secret = %v, want rotated secret to be zeroed", first)
+	}
+
+	if _, _, err := s.signRequest(ctx, "/0/private/Balance", "4", nil); err == nil {
+		t.Errorf("providerKeySigner.signRequest() error = nil, want provider error")
+	}
+}
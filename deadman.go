@@ -0,0 +1,121 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrDeadMansSwitchStarted is returned when starting a DeadMansSwitch that is already running.
+var ErrDeadMansSwitchStarted = errors.New("dead man's switch already started")
+
+// DeadMansSwitch keeps the CancelAllOrdersAfter timer of Kraken armed while the process is alive,
+// so that every open order is cancelled if it crashes or loses connectivity.
+// The timer is extended periodically in a goroutine and disabled by Stop on graceful shutdown.
+// It is safe for concurrent use.
+type DeadMansSwitch struct {
+	trading  TradingService
+	timeout  time.Duration
+	interval time.Duration
+	errs     chan error
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewDeadMansSwitch returns a new DeadMansSwitch that sets the timer to timeout every interval.
+// A zero interval defaults to a quarter of the timeout, so a few refreshes can fail before
+// the timer triggers. Kraken recommends a timeout of 60 seconds.
+// The timeout must be positive and the interval shorter than the timeout.
+func NewDeadMansSwitch(t TradingService, timeout, interval time.Duration) (*DeadMansSwitch, error) {
+	if timeout <= 0 {
+		return nil, fmt.Errorf("timeout %v must be positive", timeout)
+	}
+	if interval == 0 {
+		interval = timeout / 4
+	}
+	if interval <= 0 || interval >= timeout {
+		return nil, fmt.Errorf("interval %v must be positive and shorter than the timeout %v", interval, timeout)
+	}
+
+	return &DeadMansSwitch{
+		trading:  t,
+		timeout:  timeout,
+		interval: interval,
+		errs:     make(chan error, 1),
+	}, nil
+}
+
+// Errors returns the failures of the refreshes made in the background. Failures are
+// dropped while a previous one has not been received, so reading them is optional.
+func (d *DeadMansSwitch) Errors() <-chan error {
+	return d.errs
+}
+
+// Start arms the timer and keeps refreshing it until ctx is done or Stop is called.
+// It returns the error of the first request, in which case the switch is not started.
+// When ctx is done the timer is left armed, call Stop to disable it.
+func (d *DeadMansSwitch) Start(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.cancel != nil {
+		return ErrDeadMansSwitchStarted
+	}
+
+	if err := d.arm(ctx, d.timeout); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	d.cancel = cancel
+	d.done = make(chan struct{})
+
+	go d.run(ctx, d.done)
+
+	return nil
+}
+
+// Stop stops refreshing the timer and disables it, so that open orders are kept.
+// Stopping a switch that is not running only disables the timer.
+func (d *DeadMansSwitch) Stop(ctx context.Context) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.cancel != nil {
+		d.cancel()
+		<-d.done
+		d.cancel, d.done = nil, nil
+	}
+
+	return d.arm(ctx, 0)
+}
+
+func (d *DeadMansSwitch) run(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := d.arm(ctx, d.timeout); err != nil && ctx.Err() == nil {
+				select {
+				case d.errs <- err:
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (d *DeadMansSwitch) arm(ctx context.Context, timeout time.Duration) error {
+	_, err := d.trading.CancelAllOrdersAfter(ctx, CancelAllOrdersAfterOpts{Timeout: timeout})
+	return err
+}
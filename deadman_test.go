@@ -0,0 +1,136 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestDeadMansSwitch(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		timeouts []string
+		failing  bool
+	)
+
+	apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+
+		mu.Lock()
+		timeouts = append(timeouts, r.PostForm.Get("timeout"))
+		fail := failing
+		mu.Unlock()
+
+		if fail {
+			http.ServeFile(w, r, "testdata/error_response.json")
+			return
+		}
+		http.ServeFile(w, r, "testdata/cancel_all_orders_after.json")
+	}))
+	defer apiMock.Close()
+
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	c := New(apiMock.Client())
+	c.baseURL = baseURL
+
+	ctx := context.Background()
+
+	d, err := NewDeadMansSwitch(c.Trading, 1500*time.Millisecond, 5*time.Millisecond)
+	if err != nil {
+		t.Fatalf("NewDeadMansSwitch() error = %v", err)
+	}
+	if err := d.Start(ctx); err != nil {
+		t.Fatalf("DeadMansSwitch.Start() error = %v", err)
+	}
+	if err := d.Start(ctx); !errors.Is(err, ErrDeadMansSwitchStarted) {
+		t.Errorf("DeadMansSwitch.Start() error = %v, want %v", err, ErrDeadMansSwitchStarted)
+	}
+
+	mu.Lock()
+	failing = true
+	mu.Unlock()
+
+	select {
+	case err := <-d.Errors():
+		if err == nil {
+			t.Errorf("DeadMansSwitch.Errors() = nil, want an error")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("DeadMansSwitch.Errors() did not report the failed refresh")
+	}
+
+	mu.Lock()
+	failing = false
+	mu.Unlock()
+
+	if err := d.Stop(ctx); err != nil {
+		t.Fatalf("DeadMansSwitch.Stop() error = %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(timeouts) < 3 {
+		t.Fatalf("requests = %v, want at least 3", len(timeouts))
+	}
+	for _, got := range timeouts[:len(timeouts)-1] {
+		if got != "2" {
+			t.Errorf("timeout = %v, want %v", got, "2")
+		}
+	}
+	if got := timeouts[len(timeouts)-1]; got != "0" {
+		t.Errorf("timeout = %v, want the switch to be disarmed", got)
+	}
+}
+
+func TestDeadMansSwitch_Start_error(t *testing.T) {
+	apiMock := createFakeServer(http.StatusOK, "error_response.json")
+	defer apiMock.Close()
+
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	c := New(apiMock.Client())
+	c.baseURL = baseURL
+
+	d, err := NewDeadMansSwitch(c.Trading, time.Minute, 0)
+	if err != nil {
+		t.Fatalf("NewDeadMansSwitch() error = %v", err)
+	}
+	if err := d.Start(context.Background()); err == nil {
+		t.Errorf("DeadMansSwitch.Start() error = nil, want an error")
+	}
+	if d.interval != 15*time.Second {
+		t.Errorf("DeadMansSwitch interval = %v, want %v", d.interval, 15*time.Second)
+	}
+}
+
+func TestNewDeadMansSwitch(t *testing.T) {
+	tests := []struct {
+		name     string
+		timeout  time.Duration
+		interval time.Duration
+		wantErr  bool
+	}{
+		{name: "valid", timeout: time.Minute, interval: 15 * time.Second},
+		{name: "default interval", timeout: time.Minute},
+		{name: "zero timeout", wantErr: true},
+		{name: "negative timeout", timeout: -time.Minute, interval: time.Second, wantErr: true},
+		{name: "timeout too short for the default interval", timeout: 3 * time.Nanosecond, wantErr: true},
+		{name: "negative interval", timeout: time.Minute, interval: -time.Second, wantErr: true},
+		{name: "interval equal to the timeout", timeout: time.Minute, interval: time.Minute, wantErr: true},
+		{name: "interval longer than the timeout", timeout: time.Minute, interval: time.Hour, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewDeadMansSwitch(nil, tt.timeout, tt.interval); (err != nil) != tt.wantErr {
+				t.Errorf("NewDeadMansSwitch() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,326 @@
+package kraken
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal represents an exact decimal number, such as a price, a volume or a balance.
+// It keeps the string formatting used by Kraken, e.g. "30300.10000", so values are
+// encoded back exactly as they were received, while arithmetic is performed without
+// the precision loss of float64. The empty string is treated as zero, and so are malformed
+// decimals, which ParseDecimal and UnmarshalJSON reject, so check Valid when building
+// a Decimal from an untrusted string.
+type Decimal string
+
+// ErrDivisionByZero is returned when a Decimal is divided by zero.
+var ErrDivisionByZero = errors.New("division by zero")
+
+// maxExponent is the largest magnitude of the exponent of the decimals
+// in scientific notation, so that they can be expanded to plain notation.
+const maxExponent = 100
+
+// ParseDecimal parses a decimal number, e.g. "1.25", "-0.5" or "1e-8".
+// Scientific notation is converted to plain notation, e.g. "1e-8" is "0.00000001",
+// and exponents are limited to 100 in magnitude.
+func ParseDecimal(s string) (Decimal, error) {
+	s = strings.TrimSpace(s)
+
+	coef, scale, err := parseDecimal(s)
+	if err != nil {
+		return "", err
+	}
+
+	if strings.ContainsAny(s, "eE") {
+		return formatDecimal(coef, scale), nil
+	}
+	return Decimal(s), nil
+}
+
+// NewDecimal returns the Decimal coef * 10^-scale, e.g. NewDecimal(125, 2) is "1.25".
+func NewDecimal(coef int64, scale int32) Decimal {
+	return formatDecimal(big.NewInt(coef), scale)
+}
+
+// NewDecimalFromFloat returns the shortest Decimal representing the float.
+func NewDecimalFromFloat(f float64) Decimal {
+	return Decimal(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// String returns the decimal as formatted by Kraken.
+func (d Decimal) String() string {
+	return string(d)
+}
+
+// Valid returns true if the decimal is a well-formed number.
+func (d Decimal) Valid() bool {
+	_, _, err := parseDecimal(string(d))
+	return err == nil
+}
+
+// Float64 returns the nearest float64 value of the decimal, or 0 if it is not valid.
+func (d Decimal) Float64() float64 {
+	f, err := strconv.ParseFloat(strings.TrimSpace(string(d)), 64)
+	if err != nil {
+		return 0
+	}
+	return f
+}
+
+// Rat returns the decimal as a big.Rat.
+func (d Decimal) Rat() *big.Rat {
+	coef, scale := d.parts()
+	return new(big.Rat).SetFrac(coef, pow10(scale))
+}
+
+// Sign returns -1, 0 or +1 depending on the sign of the decimal.
+func (d Decimal) Sign() int {
+	coef, _ := d.parts()
+	return coef.Sign()
+}
+
+// IsZero returns true if the decimal is zero.
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares two decimals and returns -1, 0 or +1.
+func (d Decimal) Cmp(o Decimal) int {
+	a, b, _ := align(d, o)
+	return a.Cmp(b)
+}
+
+// Equal returns true if both decimals have the same value, regardless of their formatting.
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// Add returns d + o.
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b, scale := align(d, o)
+	return formatDecimal(a.Add(a, b), scale)
+}
+
+// Sub returns d - o.
+func (d Decimal) Sub(o Decimal) Decimal {
+	a, b, scale := align(d, o)
+	return formatDecimal(a.Sub(a, b), scale)
+}
+
+// Mul returns d * o.
+func (d Decimal) Mul(o Decimal) Decimal {
+	a, as := d.parts()
+	b, bs := o.parts()
+	return formatDecimal(a.Mul(a, b), as+bs)
+}
+
+// Div returns d / o rounded half away from zero to the given number of decimal places.
+func (d Decimal) Div(o Decimal, places int32) (Decimal, error) {
+	if o.IsZero() {
+		return "", ErrDivisionByZero
+	}
+
+	r := new(big.Rat).Quo(d.Rat(), o.Rat())
+	return roundRat(r, places), nil
+}
+
+// Neg returns -d.
+func (d Decimal) Neg() Decimal {
+	coef, scale := d.parts()
+	return formatDecimal(coef.Neg(coef), scale)
+}
+
+// Abs returns the absolute value of d.
+func (d Decimal) Abs() Decimal {
+	coef, scale := d.parts()
+	return formatDecimal(coef.Abs(coef), scale)
+}
+
+// Round rounds the decimal half away from zero to the given number of decimal places.
+// Decimals that already have fewer places are returned unchanged.
+func (d Decimal) Round(places int32) Decimal {
+	_, scale := d.parts()
+	if scale <= places {
+		return d
+	}
+	return roundRat(d.Rat(), places)
+}
+
+// Truncate rounds the decimal toward zero to the given number of decimal places.
+// Decimals that already have fewer places are returned unchanged.
+func (d Decimal) Truncate(places int32) Decimal {
+	coef, scale := d.parts()
+	if scale <= places {
+		return d
+	}
+	return formatDecimal(coef.Quo(coef, pow10(scale-places)), places)
+}
+
+// RoundToStep rounds the decimal half away from zero to the nearest multiple of step, e.g. a tick size.
+func (d Decimal) RoundToStep(step Decimal) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+
+	_, scale := step.parts()
+
+	n := new(big.Rat).Quo(d.Rat(), step.Rat())
+	multiple := roundRat(n, 0)
+	return multiple.Mul(step).Round(scale)
+}
+
+// Places returns the number of decimal places of the decimal.
+func (d Decimal) Places() int32 {
+	_, scale := d.parts()
+	return scale
+}
+
+// MarshalJSON encodes the decimal as a JSON string, as Kraken does.
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(d))
+}
+
+// UnmarshalJSON decodes a decimal from a JSON string or number, see ParseDecimal.
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	s := string(b)
+	if len(b) > 0 && b[0] == '"' {
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+	}
+
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
+
+// parts returns the coefficient and scale of the decimal. Invalid decimals are zero.
+func (d Decimal) parts() (*big.Int, int32) {
+	coef, scale, err := parseDecimal(string(d))
+	if err != nil {
+		return new(big.Int), 0
+	}
+	return coef, scale
+}
+
+// parseDecimal parses s into coef * 10^-scale.
+func parseDecimal(s string) (*big.Int, int32, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return new(big.Int), 0, nil
+	}
+
+	mantissa, exponent := s, 0
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		e, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid decimal %q", s)
+		}
+		if e > maxExponent || e < -maxExponent {
+			return nil, 0, fmt.Errorf("invalid decimal %q: exponent out of range", s)
+		}
+		mantissa, exponent = s[:i], e
+	}
+
+	neg := false
+	switch {
+	case strings.HasPrefix(mantissa, "-"):
+		neg = true
+		mantissa = mantissa[1:]
+	case strings.HasPrefix(mantissa, "+"):
+		mantissa = mantissa[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	if digits == "" || strings.Trim(digits, "0123456789") != "" {
+		return nil, 0, fmt.Errorf("invalid decimal %q", s)
+	}
+
+	coef, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		coef.Neg(coef)
+	}
+
+	scale := int32(len(fracPart) - exponent)
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+
+	return coef, scale, nil
+}
+
+// formatDecimal formats coef * 10^-scale. A negative scale appends zeros, e.g. 5 * 10^2 is "500".
+func formatDecimal(coef *big.Int, scale int32) Decimal {
+	digits := new(big.Int).Abs(coef).String()
+	if scale < 0 && coef.Sign() != 0 {
+		digits += strings.Repeat("0", int(-scale))
+	}
+	if scale > 0 {
+		if pad := int(scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		digits = digits[:len(digits)-int(scale)] + "." + digits[len(digits)-int(scale):]
+	}
+
+	if coef.Sign() < 0 {
+		digits = "-" + digits
+	}
+	return Decimal(digits)
+}
+
+// align returns the coefficients of both decimals at the same scale.
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	ac, as := a.parts()
+	bc, bs := b.parts()
+
+	switch {
+	case as < bs:
+		ac.Mul(ac, pow10(bs-as))
+		return ac, bc, bs
+	case bs < as:
+		bc.Mul(bc, pow10(as-bs))
+	}
+	return ac, bc, as
+}
+
+// roundRat rounds r half away from zero to the given number of decimal places.
+// Negative places round to tens, hundreds, etc.
+func roundRat(r *big.Rat, places int32) Decimal {
+	num := new(big.Int).Set(r.Num())
+	den := new(big.Int).Set(r.Denom())
+	if places >= 0 {
+		num.Mul(num, pow10(places))
+	} else {
+		den.Mul(den, pow10(-places))
+	}
+
+	q, m := new(big.Int).QuoRem(num, den, new(big.Int))
+
+	// Round half away from zero: |2m| >= den.
+	if m.Abs(m).Lsh(m, 1).Cmp(den) >= 0 {
+		if num.Sign() < 0 {
+			q.Sub(q, big.NewInt(1))
+		} else {
+			q.Add(q, big.NewInt(1))
+		}
+	}
+
+	return formatDecimal(q, places)
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
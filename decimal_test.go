@@ -0,0 +1,263 @@
+package kraken
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    Decimal
+		wantErr bool
+	}{
+		{name: "price", s: "30300.10000", want: "30300.10000"},
+		{name: "negative", s: "-0.5", want: "-0.5"},
+		{name: "exponent", s: "1e-8", want: "0.00000001"},
+		{name: "positive exponent", s: "1.5E3", want: "1500"},
+		{name: "exponent out of range", s: "1e1000000000", wantErr: true},
+		{name: "empty", s: "", want: ""},
+		{name: "letters", s: "1.2a", wantErr: true},
+		{name: "only sign", s: "-", wantErr: true},
+		{name: "two points", s: "1.2.3", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDecimal(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ParseDecimal() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("ParseDecimal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDecimal(t *testing.T) {
+	tests := []struct {
+		coef  int64
+		scale int32
+		want  Decimal
+	}{
+		{coef: 125, scale: 2, want: "1.25"},
+		{coef: -5, scale: 3, want: "-0.005"},
+		{coef: 42, scale: 0, want: "42"},
+		{coef: 5, scale: -2, want: "500"},
+		{coef: 0, scale: -2, want: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.want), func(t *testing.T) {
+			if got := NewDecimal(tt.coef, tt.scale); got != tt.want {
+				t.Errorf("NewDecimal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_arithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Decimal
+		want Decimal
+	}{
+		{name: "add", got: Decimal("0.1").Add("0.2"), want: "0.3"},
+		{name: "add keeps places", got: Decimal("30300.10000").Add("1"), want: "30301.10000"},
+		{name: "add empty", got: Decimal("").Add("1.5"), want: "1.5"},
+		{name: "sub", got: Decimal("1").Sub("1.25"), want: "-0.25"},
+		{name: "mul", got: Decimal("0.00067643").Mul("30303.2"), want: "20.497993576"},
+		{name: "neg", got: Decimal("1.5").Neg(), want: "-1.5"},
+		{name: "abs", got: Decimal("-1.5").Abs(), want: "1.5"},
+		{name: "exponent", got: Decimal("1e-8").Add("1"), want: "1.00000001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("Decimal = %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Div(t *testing.T) {
+	got, err := Decimal("1").Div("3", 4)
+	if err != nil || got != "0.3333" {
+		t.Errorf("Decimal.Div() = %v, %v, want %v", got, err, "0.3333")
+	}
+
+	got, err = Decimal("-2").Div("3", 2)
+	if err != nil || got != "-0.67" {
+		t.Errorf("Decimal.Div() = %v, %v, want %v", got, err, "-0.67")
+	}
+
+	if _, err := Decimal("1").Div("0.000", 2); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Decimal.Div() error = %v, want %v", err, ErrDivisionByZero)
+	}
+}
+
+func TestDecimal_Cmp(t *testing.T) {
+	tests := []struct {
+		a, b Decimal
+		want int
+	}{
+		{a: "1.10", b: "1.1", want: 0},
+		{a: "1.01", b: "1.1", want: -1},
+		{a: "2", b: "-3", want: 1},
+		{a: "", b: "0.000", want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.a)+"_"+string(tt.b), func(t *testing.T) {
+			if got := tt.a.Cmp(tt.b); got != tt.want {
+				t.Errorf("Decimal.Cmp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Round(t *testing.T) {
+	tests := []struct {
+		name   string
+		d      Decimal
+		places int32
+		want   Decimal
+	}{
+		{name: "half up", d: "1.005", places: 2, want: "1.01"},
+		{name: "half away from zero", d: "-1.005", places: 2, want: "-1.01"},
+		{name: "down", d: "30300.14999", places: 1, want: "30300.1"},
+		{name: "fewer places", d: "1.5", places: 3, want: "1.5"},
+		{name: "integer", d: "2.5", places: 0, want: "3"},
+		{name: "hundreds", d: "1250.5", places: -2, want: "1300"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Round(tt.places); got != tt.want {
+				t.Errorf("Decimal.Round() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_Truncate(t *testing.T) {
+	tests := []struct {
+		name   string
+		d      Decimal
+		places int32
+		want   Decimal
+	}{
+		{name: "positive", d: "0.123456789", places: 8, want: "0.12345678"},
+		{name: "negative", d: "-1.99", places: 1, want: "-1.9"},
+		{name: "fewer places", d: "1.5", places: 3, want: "1.5"},
+		{name: "hundreds", d: "1234.5", places: -2, want: "1200"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.Truncate(tt.places); got != tt.want {
+				t.Errorf("Decimal.Truncate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_RoundToStep(t *testing.T) {
+	tests := []struct {
+		name string
+		d    Decimal
+		step Decimal
+		want Decimal
+	}{
+		{name: "tick size", d: "30300.17", step: "0.1", want: "30300.2"},
+		{name: "half tick", d: "1.25", step: "0.5", want: "1.5"},
+		{name: "coarse tick", d: "12.3", step: "5", want: "10"},
+		{name: "no step", d: "1.23", step: "", want: "1.23"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.d.RoundToStep(tt.step); got != tt.want {
+				t.Errorf("Decimal.RoundToStep() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		b       string
+		want    Decimal
+		wantErr bool
+	}{
+		{name: "string", b: `"30300.10000"`, want: "30300.10000"},
+		{name: "number", b: `0.00067643`, want: "0.00067643"},
+		{name: "null", b: `null`, want: ""},
+		{name: "exponent", b: `1e-8`, want: "0.00000001"},
+		{name: "empty string", b: `""`, want: ""},
+		{name: "invalid string", b: `"abc"`, wantErr: true},
+		{name: "invalid", b: `true`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Decimal
+			err := json.Unmarshal([]byte(tt.b), &got)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Decimal.UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Decimal.UnmarshalJSON() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimal_encoding(t *testing.T) {
+	b, _ := json.Marshal(EarnFundsOpts{Amount: "0.10000", StrategyID: "ESRFUO3-Q62XD-WIOIL7"})
+	if want := `{"amount":"0.10000","strategy_id":"ESRFUO3-Q62XD-WIOIL7"}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	v, _ := query.Values(AddOrderOpts{Volume: "1.25000000", Price: "30300.10000"})
+	if got := v.Get("volume"); got != "1.25000000" {
+		t.Errorf("volume = %v, want %v", got, "1.25000000")
+	}
+	if got := v.Get("price"); got != "30300.10000" {
+		t.Errorf("price = %v, want %v", got, "30300.10000")
+	}
+	if _, ok := v["price2"]; ok {
+		t.Errorf("price2 = %v, want omitted", v.Get("price2"))
+	}
+}
+
+func TestExtendedBalance_Available(t *testing.T) {
+	b := ExtendedBalance{
+		Balance:    "1.0000000000",
+		Credit:     "0.5000",
+		CreditUsed: "0.1000",
+		HoldTrade:  "0.2500000000",
+	}
+
+	if got := b.Available(); got != "1.1500000000" {
+		t.Errorf("ExtendedBalance.Available() = %v, want %v", got, "1.1500000000")
+	}
+}
+
+func TestAssetPairInfo_Round(t *testing.T) {
+	info := AssetPairInfo{PairDecimals: 1, LotDecimals: 8, CostDecimals: 5, TickSize: "0.1"}
+
+	if got := info.RoundPrice("30300.17"); got != "30300.2" {
+		t.Errorf("AssetPairInfo.RoundPrice() = %v, want %v", got, "30300.2")
+	}
+	if got := (AssetPairInfo{PairDecimals: 2}).RoundPrice("1.005"); got != "1.01" {
+		t.Errorf("AssetPairInfo.RoundPrice() = %v, want %v", got, "1.01")
+	}
+	if got := info.RoundVolume("0.123456789"); got != "0.12345678" {
+		t.Errorf("AssetPairInfo.RoundVolume() = %v, want %v", got, "0.12345678")
+	}
+	if got := info.RoundCost("10.123456"); got != "10.12346" {
+		t.Errorf("AssetPairInfo.RoundCost() = %v, want %v", got, "10.12346")
+	}
+}
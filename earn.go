@@ -12,8 +12,8 @@ type Earn service
 
 // EarnFundsOpts .
 type EarnFundsOpts struct {
-	Amount     string `json:"amount"`
-	StrategyID string `json:"strategy_id"`
+	Amount     Decimal `json:"amount"`
+	StrategyID string  `json:"strategy_id"`
 }
 
 // Allocate allocates funds to the Strategy.
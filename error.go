@@ -1,14 +1,240 @@
 package kraken
 
-import "fmt"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Severity defines the severity of a message returned by the Kraken API.
+type Severity string
+
+const (
+	// SeverityError means the request failed.
+	SeverityError Severity = "E"
+	// SeverityWarning means the request succeeded but Kraken reported a warning.
+	SeverityWarning Severity = "W"
+)
+
+// APIError represents a single message returned by the Kraken API.
+// Kraken formats messages as <severity><category>:<message>[:<detail>],
+// e.g. "EOrder:Insufficient funds" or "EGeneral:Invalid arguments:volume".
+type APIError struct {
+	Severity Severity
+	Category string
+	Message  string
+	Detail   string
+}
+
+// ParseAPIError parses a Kraken API message into an APIError.
+// Messages that do not follow the Kraken format are kept as-is in the Message field.
+func ParseAPIError(s string) *APIError {
+	head, rest, found := strings.Cut(s, ":")
+	if !found || len(head) < 2 {
+		return &APIError{Message: s}
+	}
+
+	severity := Severity(head[:1])
+	if severity != SeverityError && severity != SeverityWarning {
+		return &APIError{Message: s}
+	}
+
+	msg, detail, _ := strings.Cut(rest, ":")
+
+	return &APIError{
+		Severity: severity,
+		Category: head[1:],
+		Message:  msg,
+		Detail:   detail,
+	}
+}
+
+// Error returns the message in the Kraken format.
+func (e *APIError) Error() string {
+	if e.Category == "" {
+		return e.Message
+	}
+
+	s := string(e.Severity) + e.Category + ":" + e.Message
+	if e.Detail != "" {
+		s += ":" + e.Detail
+	}
+	return s
+}
+
+// Is reports whether the target is an APIError with the same severity, category and message.
+// The detail is ignored so that, for instance, "EGeneral:Invalid arguments:volume"
+// matches ErrInvalidArguments, but a warning never matches an error.
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+
+	return e.Severity == t.Severity && e.Category == t.Category && e.Message == t.Message
+}
+
+// IsWarning returns true if the message is a warning.
+func (e *APIError) IsWarning() bool {
+	return e.Severity == SeverityWarning
+}
+
+// Errors returned by the Kraken API that can be checked with errors.Is.
+// Docs: https://docs.kraken.com/rest/#section/General-Usage/Error-Details
+var (
+	ErrInvalidNonce           error = ParseAPIError("EAPI:Invalid nonce")
+	ErrInvalidKey             error = ParseAPIError("EAPI:Invalid key")
+	ErrInvalidSignature       error = ParseAPIError("EAPI:Invalid signature")
+	ErrRateLimitExceeded      error = ParseAPIError("EAPI:Rate limit exceeded")
+	ErrFeatureDisabled        error = ParseAPIError("EAPI:Feature disabled")
+	ErrPermissionDenied       error = ParseAPIError("EGeneral:Permission denied")
+	ErrInvalidArguments       error = ParseAPIError("EGeneral:Invalid arguments")
+	ErrTemporaryLockout       error = ParseAPIError("EGeneral:Temporary lockout")
+	ErrUnknownMethod          error = ParseAPIError("EGeneral:Unknown method")
+	ErrInternalError          error = ParseAPIError("EGeneral:Internal error")
+	ErrServiceUnavailable     error = ParseAPIError("EService:Unavailable")
+	ErrServiceBusy            error = ParseAPIError("EService:Busy")
+	ErrMarketCancelOnly       error = ParseAPIError("EService:Market in cancel_only mode")
+	ErrMarketPostOnly         error = ParseAPIError("EService:Market in post_only mode")
+	ErrDeadlineElapsed        error = ParseAPIError("EService:Deadline elapsed")
+	ErrInsufficientFunds      error = ParseAPIError("EOrder:Insufficient funds")
+	ErrInsufficientMargin     error = ParseAPIError("EOrder:Insufficient margin")
+	ErrOrderNotFound          error = ParseAPIError("EOrder:Unknown order")
+	ErrOrderMinimumNotMet     error = ParseAPIError("EOrder:Order minimum not met")
+	ErrCostMinimumNotMet      error = ParseAPIError("EOrder:Cost minimum not met")
+	ErrInvalidPrice           error = ParseAPIError("EOrder:Invalid price")
+	ErrOrdersLimitExceeded    error = ParseAPIError("EOrder:Orders limit exceeded")
+	ErrOrderRateLimitExceeded error = ParseAPIError("EOrder:Rate limit exceeded")
+	ErrPositionsLimitExceeded error = ParseAPIError("EOrder:Positions limit exceeded")
+	ErrUnknownAssetPair       error = ParseAPIError("EQuery:Unknown asset pair")
+	ErrUnknownAsset           error = ParseAPIError("EQuery:Unknown asset")
+	ErrTradeLocked            error = ParseAPIError("ETrade:Locked")
+	ErrUnknownFundingMethod   error = ParseAPIError("EFunding:Unknown method")
+)
 
 // Error represents a Kraken API error.
+// It holds every message returned in the "error" field of the response,
+// split into errors and warnings, together with the HTTP status code.
 type Error struct {
-	errors []string
+	StatusCode int
+	Errors     []*APIError
+	Warnings   []*APIError
+}
+
+func newError(statusCode int, messages []string) *Error {
+	e := &Error{StatusCode: statusCode}
+
+	for _, m := range messages {
+		apiErr := ParseAPIError(m)
+		if apiErr.IsWarning() {
+			e.Warnings = append(e.Warnings, apiErr)
+			continue
+		}
+		e.Errors = append(e.Errors, apiErr)
+	}
+
+	return e
 }
 
 // Error builds a Kraken API error.
 func (e *Error) Error() string {
-	// TODO: improve error message
-	return fmt.Sprintf("%v", e.errors)
+	msgs := e.Errors
+	if len(msgs) == 0 {
+		msgs = e.Warnings
+	}
+
+	s := make([]string, 0, len(msgs))
+	for _, m := range msgs {
+		s = append(s, m.Error())
+	}
+	return strings.Join(s, "; ")
+}
+
+// Unwrap returns the individual API errors, so that errors.Is and errors.As
+// can be used to match any of them.
+func (e *Error) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, m := range e.Errors {
+		errs = append(errs, m)
+	}
+	return errs
+}
+
+// maxErrorBodySize is the maximum number of bytes of the response body kept in errors.
+const maxErrorBodySize = 512
+
+// ErrEmptyResponse is returned when Kraken replies with an empty body.
+var ErrEmptyResponse = errors.New("empty response body")
+
+// TransportError is returned when the request could not be sent
+// or the response could not be read.
+type TransportError struct {
+	Err error
+}
+
+// Error returns the underlying transport error message.
+func (e *TransportError) Error() string {
+	return fmt.Sprintf("transport error: %v", e.Err)
+}
+
+// Unwrap returns the underlying transport error.
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// HTTPError is returned when Kraken replies with a non successful HTTP status code
+// and the body is not a Kraken error response, e.g. a Cloudflare HTML page.
+type HTTPError struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte // Response body, truncated to 512 bytes.
+}
+
+func newHTTPError(r *http.Response, body []byte) *HTTPError {
+	return &HTTPError{
+		StatusCode: r.StatusCode,
+		Status:     r.Status,
+		Header:     r.Header,
+		Body:       truncateBody(body),
+	}
+}
+
+// Error builds the HTTP error message.
+func (e *HTTPError) Error() string {
+	status := e.Status
+	if status == "" {
+		status = fmt.Sprintf("%d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+
+	if len(e.Body) == 0 {
+		return fmt.Sprintf("unexpected HTTP status %s", status)
+	}
+	return fmt.Sprintf("unexpected HTTP status %s: %s", status, e.Body)
+}
+
+// DecodeError is returned when a successful response can not be decoded.
+type DecodeError struct {
+	StatusCode int
+	Body       []byte // Response body, truncated to 512 bytes.
+	Err        error
+}
+
+// Error builds the decode error message.
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("decoding response: %v", e.Err)
+}
+
+// Unwrap returns the underlying decode error.
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func truncateBody(b []byte) []byte {
+	if len(b) > maxErrorBodySize {
+		b = b[:maxErrorBodySize]
+	}
+	return bytes.Clone(b)
 }
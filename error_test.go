@@ -1,6 +1,56 @@
 package kraken
 
-import "testing"
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestParseAPIError(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want *APIError
+	}{
+		{
+			name: "error",
+			s:    "EOrder:Insufficient funds",
+			want: &APIError{Severity: SeverityError, Category: "Order", Message: "Insufficient funds"},
+		},
+		{
+			name: "error with detail",
+			s:    "EGeneral:Invalid arguments:volume",
+			want: &APIError{Severity: SeverityError, Category: "General", Message: "Invalid arguments", Detail: "volume"},
+		},
+		{
+			name: "warning",
+			s:    "WGeneral:Deprecated",
+			want: &APIError{Severity: SeverityWarning, Category: "General", Message: "Deprecated"},
+		},
+		{
+			name: "unknown format",
+			s:    "error",
+			want: &APIError{Message: "error"},
+		},
+		{
+			name: "unknown severity",
+			s:    "XOrder:Unknown",
+			want: &APIError{Message: "XOrder:Unknown"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseAPIError(tt.s)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseAPIError() = %v, want %v", got, tt.want)
+			}
+			if got.Error() != tt.s {
+				t.Errorf("APIError.Error() = %v, want %v", got.Error(), tt.s)
+			}
+		})
+	}
+}
 
 func TestError_Error(t *testing.T) {
 	type fields struct {
@@ -11,16 +61,109 @@ func TestError_Error(t *testing.T) {
 		fields fields
 		want   string
 	}{
-		{name: "error", fields: fields{errors: []string{"error"}}, want: "[error]"},
+		{name: "error", fields: fields{errors: []string{"error"}}, want: "error"},
+		{
+			name:   "multiple errors",
+			fields: fields{errors: []string{"EAPI:Invalid key", "EGeneral:Permission denied"}},
+			want:   "EAPI:Invalid key; EGeneral:Permission denied",
+		},
+		{
+			name:   "errors and warnings",
+			fields: fields{errors: []string{"WGeneral:Deprecated", "EOrder:Insufficient funds"}},
+			want:   "EOrder:Insufficient funds",
+		},
+		{name: "only warnings", fields: fields{errors: []string{"WGeneral:Deprecated"}}, want: "WGeneral:Deprecated"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			e := &Error{
-				errors: tt.fields.errors,
-			}
+			e := newError(http.StatusOK, tt.fields.errors)
 			if got := e.Error(); got != tt.want {
 				t.Errorf("Error.Error() = %v, want %v", got, tt.want)
 			}
 		})
 	}
 }
+
+func TestError_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		errors []string
+		target error
+		want   bool
+	}{
+		{name: "invalid nonce", errors: []string{"EAPI:Invalid nonce"}, target: ErrInvalidNonce, want: true},
+		{name: "rate limit", errors: []string{"EGeneral:Permission denied", "EAPI:Rate limit exceeded"}, target: ErrRateLimitExceeded, want: true},
+		{name: "detail is ignored", errors: []string{"EGeneral:Invalid arguments:volume"}, target: ErrInvalidArguments, want: true},
+		{name: "different error", errors: []string{"EOrder:Insufficient funds"}, target: ErrOrderNotFound, want: false},
+		{name: "warnings are not matched", errors: []string{"WService:Unavailable"}, target: ErrServiceUnavailable, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := error(newError(http.StatusOK, tt.errors))
+			if got := errors.Is(err, tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResponse_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		errors  []string
+		want    error
+		wantErr bool
+	}{
+		{name: "no errors"},
+		{name: "only warnings", errors: []string{"WGeneral:Deprecated"}},
+		{
+			name:   "errors",
+			errors: []string{"WGeneral:Deprecated", "EOrder:Unknown order"},
+			want: &Error{
+				StatusCode: http.StatusBadRequest,
+				Errors:     []*APIError{{Severity: SeverityError, Category: "Order", Message: "Unknown order"}},
+				Warnings:   []*APIError{{Severity: SeverityWarning, Category: "General", Message: "Deprecated"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Response{Errors: tt.errors}
+			if got := r.error(http.StatusBadRequest); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Response.error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIError_Is(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    string
+		target error
+		want   bool
+	}{
+		{name: "same error", msg: "EService:Unavailable", target: ErrServiceUnavailable, want: true},
+		{name: "warning", msg: "WService:Unavailable", target: ErrServiceUnavailable, want: false},
+		{name: "unformatted message", msg: "Service:Unavailable", target: ErrServiceUnavailable, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := errors.Is(ParseAPIError(tt.msg), tt.target); got != tt.want {
+				t.Errorf("errors.Is() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResponse_Warnings(t *testing.T) {
+	r := &Response{Errors: []string{"WGeneral:Deprecated", "EOrder:Unknown order", "WOrder:Close to limit:XXBTZUSD"}}
+
+	want := []*APIError{
+		{Severity: SeverityWarning, Category: "General", Message: "Deprecated"},
+		{Severity: SeverityWarning, Category: "Order", Message: "Close to limit", Detail: "XXBTZUSD"},
+	}
+	if got := r.Warnings(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Response.Warnings() = %v, want %v", got, want)
+	}
+}
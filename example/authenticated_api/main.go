@@ -10,9 +10,10 @@ import (
 func main() {
 	ctx := context.Background()
 
+	// Credentials are loaded from the KRAKEN_API_KEY and KRAKEN_API_SECRET environment variables.
 	c := kraken.New(nil).
-		WithAuth(
-			kraken.Secrets{},
+		WithCredentials(
+			kraken.EnvCredentials{},
 		)
 
 	st, err := c.Account.Balance(ctx)
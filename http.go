@@ -1,12 +1,14 @@
 package kraken
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -18,14 +20,33 @@ type Response struct {
 }
 
 // Error builds a Kraken API error.
+// It returns nil if the response only contains warnings.
 func (r *Response) Error() error {
+	return r.error(0)
+}
+
+// Warnings returns the warnings of the response, reported even if the request succeeded.
+func (r *Response) Warnings() []*APIError {
+	var warnings []*APIError
+	for _, m := range r.Errors {
+		if apiErr := ParseAPIError(m); apiErr.IsWarning() {
+			warnings = append(warnings, apiErr)
+		}
+	}
+	return warnings
+}
+
+func (r *Response) error(statusCode int) error {
 	if len(r.Errors) == 0 {
 		return nil
 	}
 
-	return &Error{
-		errors: r.Errors,
+	e := newError(statusCode, r.Errors)
+	if len(e.Errors) == 0 {
+		return nil
 	}
+
+	return e
 }
 
 func (c *Client) buildPublicURL(path string) *url.URL {
@@ -38,6 +59,34 @@ func (c *Client) buildPrivateURL(path string) *url.URL {
 	return u
 }
 
+// requestInfo describes the Kraken endpoint targeted by a request.
+type requestInfo struct {
+	endpoint string // Endpoint name, e.g. "AddOrder" or "Earn/Strategies".
+	private  bool
+	body     reqBody // Body of private requests, kept to sign them again on retries.
+	// reserve is called before each attempt, e.g. to charge the trading counters.
+	reserve func(ctx context.Context) error
+}
+
+type requestInfoKey struct{}
+
+func withRequestInfo(req *http.Request, info requestInfo) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), requestInfoKey{}, info))
+}
+
+func requestInfoFrom(req *http.Request) requestInfo {
+	info, _ := req.Context().Value(requestInfoKey{}).(requestInfo)
+	return info
+}
+
+// withReservation reserves a cost before each attempt of the request, so that retries
+// are charged as well as the first attempt.
+func withReservation(req *http.Request, reserve func(ctx context.Context) error) *http.Request {
+	info := requestInfoFrom(req)
+	info.reserve = reserve
+	return withRequestInfo(req, info)
+}
+
 func (c *Client) newPublicRequest(ctx context.Context, method string, path string, body io.Reader) (*http.Request, error) {
 	reqURL := c.buildPublicURL(path).String()
 
@@ -46,6 +95,9 @@ func (c *Client) newPublicRequest(ctx context.Context, method string, path strin
 		return nil, err
 	}
 
+	endpoint, _, _ := strings.Cut(path, "?")
+	req = withRequestInfo(req, requestInfo{endpoint: endpoint})
+
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
@@ -56,55 +108,220 @@ func (c *Client) newPublicRequest(ctx context.Context, method string, path strin
 	return req, nil
 }
 
+// newPrivateRequest builds a private request. The request is signed with a new nonce
+// right before it is sent, see signPrivateRequest.
 func (c *Client) newPrivateRequest(ctx context.Context, method string, path string, body reqBody) (*http.Request, error) {
 	reqURL := c.buildPrivateURL(path)
 
-	if otp := OtpFromContext(ctx); otp != "" {
-		body.withOtp(otp)
+	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return withRequestInfo(req, requestInfo{endpoint: path, private: true, body: body}), nil
+}
+
+// otp returns the one-time password of a private request, from the context or the
+// OTP provider of the client.
+func (c *Client) otp(ctx context.Context) (Otp, error) {
+	if otp := OtpFromContext(ctx); otp != "" || c.otpProvider == nil {
+		return otp, nil
+	}
+	return c.otpProvider.Otp(ctx)
+}
+
+// signPrivateRequest stamps a new nonce and the one-time password on the body
+// of the request and signs it.
+func (c *Client) signPrivateRequest(req *http.Request, info requestInfo, key *apiKeyState, otp Otp) (*http.Request, error) {
+	if otp != "" {
+		info.body.withOtp(otp)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, reqURL.String(), strings.NewReader(body.string()))
+	nonce, err := key.nonceSource.Nonce()
 	if err != nil {
 		return nil, err
 	}
 
-	signature := c.signer.Sign(body, reqURL.Path)
+	info.body.withNonce(strconv.FormatUint(nonce, 10))
 
-	req.Header.Set("API-Key", string(c.apiKey))
-	req.Header.Set("API-Sign", signature)
-	req.Header.Set("Content-Type", body.contentType())
+	body := info.body.string()
 
-	return req, nil
+	signed, err := http.NewRequestWithContext(req.Context(), req.Method, req.URL.String(), strings.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, signature, err := key.auth.signRequest(req.Context(), req.URL.Path, info.body.nonce(), []byte(body))
+	if err != nil {
+		return nil, err
+	}
+
+	signed.Header = req.Header.Clone()
+	signed.Header.Set("API-Key", string(apiKey))
+	signed.Header.Set("API-Sign", signature)
+	signed.Header.Set("Content-Type", info.body.contentType())
+
+	return signed, nil
 }
 
+// do sends the request and decodes the response into v.
+// Failed requests are retried according to the retry policy of the client.
+// Private requests get a new nonce and signature on each attempt.
 func (c *Client) do(req *http.Request, v any) error {
+	info := requestInfoFrom(req)
+
+	for attempt := 1; ; attempt++ {
+		err := c.send(req, info, attempt, v)
+		if err == nil || !c.retryPolicy.shouldRetry(attempt, info, err) {
+			return err
+		}
+
+		if err := sleep(req.Context(), c.retryPolicy.backoff(attempt)); err != nil {
+			return err
+		}
+	}
+}
+
+// send performs an attempt of the call through the middlewares of the client.
+func (c *Client) send(req *http.Request, info requestInfo, attempt int, v any) error {
+	call := &Call{
+		Endpoint: info.endpoint,
+		Private:  info.private,
+		Attempt:  attempt,
+		Request:  req,
+		body:     info.body,
+	}
+
+	return c.chain(info.endpoint, func(call *Call) error {
+		return c.sendCall(call, info, v)
+	})(call)
+}
+
+func (c *Client) sendCall(call *Call, info requestInfo, v any) error {
+	req := call.Request
+
+	var limiter *RateLimiter
+
+	if info.private {
+		key, err := c.keyFor(info.endpoint)
+		if err != nil {
+			return err
+		}
+
+		limiter = key.rateLimiter
+		call.rateLimiter = limiter
+		if limiter != nil {
+			if err := limiter.Wait(req.Context(), info.endpoint); err != nil {
+				return err
+			}
+		}
+
+		if info.reserve != nil {
+			if err := info.reserve(req.Context()); err != nil {
+				return err
+			}
+		}
+
+		// The OTP provider may wait for the next time step, so the password is
+		// obtained before the nonce lock, not to block the other requests of the key.
+		otp, err := c.otp(req.Context())
+		if err != nil {
+			return err
+		}
+
+		// Nonces must reach Kraken in increasing order. Holding the lock from the nonce
+		// generation until the response is received guarantees it at the cost of concurrency.
+		if key.requests != nil {
+			select {
+			case key.requests <- struct{}{}:
+				defer func() { <-key.requests }()
+			case <-req.Context().Done():
+				return req.Context().Err()
+			}
+		}
+
+		signed, err := c.signPrivateRequest(req, info, key, otp)
+		if err != nil {
+			return err
+		}
+		req = signed
+	}
+
+	call.sent = req
+	start := time.Now()
+
 	resp, err := c.client.Do(req)
 	if err != nil {
-		return err
+		call.Latency = time.Since(start)
+		return &TransportError{Err: err}
 	}
 	defer resp.Body.Close()
 
-	return decodeResponse(resp, v)
+	call.Warnings, err = decodeResponse(resp, v)
+
+	call.StatusCode = resp.StatusCode
+	call.Latency = time.Since(start)
+	if err == nil {
+		call.Result = v
+	}
+
+	if limiter != nil {
+		limiter.observe(err)
+	}
+
+	return err
 }
 
-func decodeResponse(r *http.Response, v any) error {
+// decodeResponse decodes the Kraken response into v and returns its warnings.
+// Kraken errors are reported even if the HTTP status code is not successful,
+// any other non 2xx response is reported as an HTTPError.
+func decodeResponse(r *http.Response, v any) ([]*APIError, error) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, &TransportError{Err: err}
+	}
+
+	success := r.StatusCode >= 200 && r.StatusCode < 300
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		if !success {
+			return nil, newHTTPError(r, body)
+		}
+		return nil, &DecodeError{StatusCode: r.StatusCode, Err: ErrEmptyResponse}
+	}
+
 	var res Response
 
 	res.Result = v
 
-	err := json.NewDecoder(r.Body).Decode(&res)
-	if err != nil && err != io.EOF {
-		return err
+	if err := json.Unmarshal(body, &res); err != nil {
+		if !success {
+			return nil, newHTTPError(r, body)
+		}
+		return nil, &DecodeError{StatusCode: r.StatusCode, Body: truncateBody(body), Err: err}
+	}
+
+	warnings := res.Warnings()
+
+	if err := res.error(r.StatusCode); err != nil {
+		return warnings, err
 	}
 
-	return res.Error()
+	if !success {
+		return warnings, newHTTPError(r, body)
+	}
+
+	return warnings, nil
 }
 
 type reqBody interface {
 	string() string
 	nonce() string
+	value(key string) string
+	withNonce(nonce string)
 	withOtp(otp Otp)
 	contentType() string
+	params() url.Values
 }
 
 type formURLEncodedBody struct {
@@ -116,7 +333,6 @@ func newFormURLEncodedBody(b url.Values) formURLEncodedBody {
 		b = url.Values{}
 	}
 
-	b.Set(nonceKey, fmt.Sprintf("%d", time.Now().UnixNano()))
 	return formURLEncodedBody{b}
 }
 
@@ -128,14 +344,30 @@ func (b formURLEncodedBody) withOtp(otp Otp) {
 	b.Set("otp", string(otp))
 }
 
+func (b formURLEncodedBody) withNonce(nonce string) {
+	b.Set(nonceKey, nonce)
+}
+
 func (b formURLEncodedBody) nonce() string {
 	return b.Get(nonceKey)
 }
 
+func (b formURLEncodedBody) value(key string) string {
+	return b.Get(key)
+}
+
 func (b formURLEncodedBody) contentType() string {
 	return "application/x-www-form-urlencoded; charset=utf-8"
 }
 
+func (b formURLEncodedBody) params() url.Values {
+	params := make(url.Values, len(b.Values))
+	for k, v := range b.Values {
+		params[k] = append([]string(nil), v...)
+	}
+	return params
+}
+
 type jsonMessage map[string]any
 
 type jsonBody struct {
@@ -154,7 +386,10 @@ func newJSONBody(b any) (jsonBody, error) {
 		return jsonBody{}, err
 	}
 
-	msg["nonce"] = fmt.Sprintf("%d", time.Now().UnixNano())
+	if msg == nil {
+		msg = jsonMessage{}
+	}
+
 	return jsonBody{msg}, nil
 }
 
@@ -167,13 +402,33 @@ func (b jsonBody) withOtp(otp Otp) {
 	b.jsonMessage["otp"] = string(otp)
 }
 
+func (b jsonBody) withNonce(nonce string) {
+	b.jsonMessage[nonceKey] = nonce
+}
+
 func (b jsonBody) nonce() string {
-	if nonce, ok := b.jsonMessage["nonce"].(string); ok {
-		return nonce
+	return b.value(nonceKey)
+}
+
+func (b jsonBody) value(key string) string {
+	switch v := b.jsonMessage[key].(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
 	}
-	return ""
 }
 
 func (b jsonBody) contentType() string {
 	return "application/json"
 }
+
+func (b jsonBody) params() url.Values {
+	params := make(url.Values, len(b.jsonMessage))
+	for k := range b.jsonMessage {
+		params.Set(k, b.value(k))
+	}
+	return params
+}
@@ -1,7 +1,14 @@
 package kraken
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
 	"net/url"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -130,3 +137,137 @@ func Test_newJSONBody(t *testing.T) {
 		})
 	}
 }
+
+func Test_decodeResponse(t *testing.T) {
+	newResponse := func(statusCode int, body string) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+			Header:     http.Header{"Content-Type": []string{"text/html"}},
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}
+	}
+
+	tests := []struct {
+		name         string
+		resp         *http.Response
+		want         ServerTime
+		wantWarnings []*APIError
+		wantErr      error
+	}{
+		{
+			name: "success",
+			resp: newResponse(http.StatusOK, `{"error":[],"result":{"unixtime":1688669448}}`),
+			want: ServerTime{UnixTime: 1688669448},
+		},
+		{
+			name:         "success with warnings",
+			resp:         newResponse(http.StatusOK, `{"error":["WGeneral:Deprecated"],"result":{"unixtime":1688669448}}`),
+			want:         ServerTime{UnixTime: 1688669448},
+			wantWarnings: []*APIError{{Severity: SeverityWarning, Category: "General", Message: "Deprecated"}},
+		},
+		{
+			name: "kraken error",
+			resp: newResponse(http.StatusOK, `{"error":["EAPI:Invalid nonce"]}`),
+			wantErr: &Error{
+				StatusCode: http.StatusOK,
+				Errors:     []*APIError{{Severity: SeverityError, Category: "API", Message: "Invalid nonce"}},
+			},
+		},
+		{
+			name: "kraken error with unsuccessful status",
+			resp: newResponse(http.StatusForbidden, `{"error":["EAPI:Invalid key"]}`),
+			wantErr: &Error{
+				StatusCode: http.StatusForbidden,
+				Errors:     []*APIError{{Severity: SeverityError, Category: "API", Message: "Invalid key"}},
+			},
+		},
+		{
+			name: "html error page",
+			resp: newResponse(http.StatusBadGateway, "<html>bad gateway</html>"),
+			wantErr: &HTTPError{
+				StatusCode: http.StatusBadGateway,
+				Status:     "502 Bad Gateway",
+				Header:     http.Header{"Content-Type": []string{"text/html"}},
+				Body:       []byte("<html>bad gateway</html>"),
+			},
+		},
+		{
+			name: "empty body with unsuccessful status",
+			resp: newResponse(520, ""),
+			wantErr: &HTTPError{
+				StatusCode: 520,
+				Status:     "520 ",
+				Header:     http.Header{"Content-Type": []string{"text/html"}},
+				Body:       []byte{},
+			},
+		},
+		{
+			name:    "empty body",
+			resp:    newResponse(http.StatusOK, ""),
+			wantErr: &DecodeError{StatusCode: http.StatusOK, Err: ErrEmptyResponse},
+		},
+		{
+			name: "json without errors and unsuccessful status",
+			resp: newResponse(http.StatusServiceUnavailable, `{"error":[]}`),
+			wantErr: &HTTPError{
+				StatusCode: http.StatusServiceUnavailable,
+				Status:     "503 Service Unavailable",
+				Header:     http.Header{"Content-Type": []string{"text/html"}},
+				Body:       []byte(`{"error":[]}`),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got ServerTime
+			warnings, err := decodeResponse(tt.resp, &got)
+			if !reflect.DeepEqual(err, tt.wantErr) {
+				t.Errorf("decodeResponse() error = %#v, wantErr %#v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("decodeResponse() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(warnings, tt.wantWarnings) {
+				t.Errorf("decodeResponse() warnings = %v, want %v", warnings, tt.wantWarnings)
+			}
+		})
+	}
+}
+
+func Test_decodeResponse_invalidJSON(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader("<html>maintenance</html>")),
+	}
+
+	_, err := decodeResponse(resp, nil)
+
+	var decodeErr *DecodeError
+	if !errors.As(err, &decodeErr) {
+		t.Fatalf("decodeResponse() error = %v, want DecodeError", err)
+	}
+	if string(decodeErr.Body) != "<html>maintenance</html>" {
+		t.Errorf("DecodeError.Body = %s, want %s", decodeErr.Body, "<html>maintenance</html>")
+	}
+}
+
+func TestClient_do_transportError(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := New(nil)
+
+	req, _ := c.newPublicRequest(ctx, http.MethodGet, "Time", nil)
+
+	err := c.do(req, nil)
+
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) {
+		t.Fatalf("Client.do() error = %v, want TransportError", err)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Client.do() error = %v, want context.Canceled", err)
+	}
+}
@@ -0,0 +1,218 @@
+// Command assetgen generates the Asset and AssetPair constants of the kraken package
+// and their metadata from snapshots of the Assets and AssetPairs endpoints, e.g.
+//
+//	curl https://api.kraken.com/0/public/Assets > testdata/asset_info.json
+//	curl https://api.kraken.com/0/public/AssetPairs > testdata/asset_pairs.json
+//	go generate ./...
+//
+// Constant names are the Kraken names without the characters that are not valid in
+// Go identifiers, e.g. FLOWS for FLOW.S. A leading digit is spelled out, e.g. ONEINCH
+// for 1INCH, and pairs named like an asset get a Pair suffix, e.g. TUSDPair.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+type assetInfo struct {
+	Altname         string `json:"altname"`
+	AssetClass      string `json:"aclass"`
+	Decimals        int    `json:"decimals"`
+	DisplayDecimals int    `json:"display_decimals"`
+	Status          string `json:"status"`
+}
+
+type assetPairInfo struct {
+	Altname       string `json:"altname"`
+	WSName        string `json:"wsname"`
+	AClassBase    string `json:"aclass_base"`
+	Base          string `json:"base"`
+	AClassQuote   string `json:"aclass_quote"`
+	Quote         string `json:"quote"`
+	PairDecimals  int    `json:"pair_decimals"`
+	CostDecimals  int    `json:"cost_decimals"`
+	LotDecimals   int    `json:"lot_decimals"`
+	LotMultiplier int    `json:"lot_multiplier"`
+	OrderMin      string `json:"ordermin"`
+	CostMin       string `json:"costmin"`
+	TickSize      string `json:"tick_size"`
+	Status        string `json:"status"`
+}
+
+type asset struct {
+	Ident string
+	Name  string
+	Info  assetInfo
+}
+
+type assetPair struct {
+	Ident string
+	Name  string
+	Info  assetPairInfo
+}
+
+var digits = []string{"ZERO", "ONE", "TWO", "THREE", "FOUR", "FIVE", "SIX", "SEVEN", "EIGHT", "NINE"}
+
+func main() {
+	assetsFile := flag.String("assets", "testdata/asset_info.json", "snapshot of the Assets endpoint")
+	pairsFile := flag.String("pairs", "testdata/asset_pairs.json", "snapshot of the AssetPairs endpoint")
+	output := flag.String("o", "assets_gen.go", "output file")
+	pkg := flag.String("package", "kraken", "package of the output file")
+	flag.Parse()
+
+	src, err := generate(*pkg, *assetsFile, *pairsFile)
+	if err != nil {
+		log.Fatalf("assetgen: %v", err)
+	}
+
+	if err := os.WriteFile(*output, src, 0o600); err != nil {
+		log.Fatalf("assetgen: %v", err)
+	}
+}
+
+// generate returns the formatted source code of the constants of the snapshots.
+func generate(pkg, assetsFile, pairsFile string) ([]byte, error) {
+	var assetInfos map[string]assetInfo
+	if err := readResult(assetsFile, &assetInfos); err != nil {
+		return nil, err
+	}
+
+	var pairInfos map[string]assetPairInfo
+	if err := readResult(pairsFile, &pairInfos); err != nil {
+		return nil, err
+	}
+
+	idents := map[string]string{}
+
+	assets := make([]asset, 0, len(assetInfos))
+	for _, name := range sortedKeys(assetInfos) {
+		ident := identifier(name)
+		if other, ok := idents[ident]; ok {
+			return nil, fmt.Errorf("assets %s and %s are both named %s", other, name, ident)
+		}
+		idents[ident] = name
+
+		assets = append(assets, asset{Ident: ident, Name: name, Info: assetInfos[name]})
+	}
+
+	pairs := make([]assetPair, 0, len(pairInfos))
+	for _, name := range sortedKeys(pairInfos) {
+		ident := identifier(name)
+		if _, ok := idents[ident]; ok {
+			ident += "Pair"
+		}
+		if other, ok := idents[ident]; ok {
+			return nil, fmt.Errorf("%s and %s are both named %s", other, name, ident)
+		}
+		idents[ident] = name
+
+		pairs = append(pairs, assetPair{Ident: ident, Name: name, Info: pairInfos[name]})
+	}
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, map[string]any{
+		"Package": pkg,
+		"Assets":  assets,
+		"Pairs":   pairs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// readResult decodes the result of a Kraken API response saved in a file.
+func readResult(file string, v any) error {
+	b, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+
+	var res struct {
+		Errors []string        `json:"error"`
+		Result json.RawMessage `json:"result"`
+	}
+	if err := json.Unmarshal(b, &res); err != nil {
+		return fmt.Errorf("decoding %s: %w", file, err)
+	}
+	if len(res.Errors) > 0 {
+		return fmt.Errorf("%s holds an error response: %s", file, strings.Join(res.Errors, ", "))
+	}
+
+	if err := json.Unmarshal(res.Result, v); err != nil {
+		return fmt.Errorf("decoding %s: %w", file, err)
+	}
+	return nil
+}
+
+// identifier returns the Go identifier of a Kraken name.
+func identifier(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(name) {
+		switch {
+		case r >= 'A' && r <= 'Z':
+			b.WriteRune(r)
+		case r >= '0' && r <= '9':
+			if b.Len() == 0 {
+				b.WriteString(digits[r-'0'])
+			} else {
+				b.WriteRune(r)
+			}
+		}
+	}
+	return b.String()
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+var tmpl = template.Must(template.New("assets").Parse(`// Code generated by assetgen from {{len .Assets}} assets and {{len .Pairs}} asset pairs. DO NOT EDIT.
+
+package {{.Package}}
+
+// Assets available on Kraken.
+const (
+{{- range .Assets}}
+	{{.Ident}} Asset = {{printf "%q" .Name}}
+{{- end}}
+)
+
+// Asset pairs available on Kraken.
+const (
+{{- range .Pairs}}
+	{{.Ident}} AssetPair = {{printf "%q" .Name}}
+{{- end}}
+)
+
+// KnownAssets holds the metadata of the assets available on Kraken when the constants were generated.
+// Use MarketData.Assets for up to date information.
+var KnownAssets = Assets{
+{{- range .Assets}}
+	{{.Ident}}: {Altname: {{printf "%q" .Info.Altname}}, AssetClass: {{printf "%q" .Info.AssetClass}}, Decimals: {{.Info.Decimals}}, DisplayDecimals: {{.Info.DisplayDecimals}}, Status: {{printf "%q" .Info.Status}}},
+{{- end}}
+}
+
+// KnownAssetPairs holds the metadata of the asset pairs available on Kraken when the constants were generated.
+// Fees and leverage are not included. Use MarketData.TradableAssetPairs for up to date information.
+var KnownAssetPairs = AssetPairs{
+{{- range .Pairs}}
+	{{.Ident}}: {Altname: {{printf "%q" .Info.Altname}}, WSName: {{printf "%q" .Info.WSName}}, AClassBase: {{printf "%q" .Info.AClassBase}}, Base: {{printf "%q" .Info.Base}}, AClassQuote: {{printf "%q" .Info.AClassQuote}}, Quote: {{printf "%q" .Info.Quote}}, PairDecimals: {{.Info.PairDecimals}}, CostDecimals: {{.Info.CostDecimals}}, LotDecimals: {{.Info.LotDecimals}}, LotMultiplier: {{.Info.LotMultiplier}}, OrderMin: {{printf "%q" .Info.OrderMin}}, CostMin: {{printf "%q" .Info.CostMin}}, TickSize: {{printf "%q" .Info.TickSize}}, Status: {{printf "%q" .Info.Status}}},
+{{- end}}
+}
+`))
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_identifier(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+	}{
+		{name: "XXBT", want: "XXBT"},
+		{name: "FLOW.S", want: "FLOWS"},
+		{name: "AUD.HOLD", want: "AUDHOLD"},
+		{name: "SOL03.S", want: "SOL03S"},
+		{name: "1INCH", want: "ONEINCH"},
+		{name: "1INCHEUR", want: "ONEINCHEUR"},
+		{name: "eth2.s", want: "ETH2S"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := identifier(tt.name); got != tt.want {
+				t.Errorf("identifier() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_generate(t *testing.T) {
+	dir := t.TempDir()
+
+	assets := filepath.Join(dir, "assets.json")
+	pairs := filepath.Join(dir, "pairs.json")
+
+	_ = os.WriteFile(assets, []byte(`{"error":[],"result":{
+		"TUSD":{"altname":"TUSD","aclass":"currency","decimals":8,"display_decimals":2,"status":"enabled"},
+		"1INCH":{"altname":"1INCH","aclass":"currency","decimals":10,"display_decimals":5,"status":"enabled"}
+	}}`), 0o600)
+	_ = os.WriteFile(pairs, []byte(`{"error":[],"result":{
+		"TUSD":{"altname":"TUSD","wsname":"T/USD","base":"T","quote":"ZUSD","ordermin":"130","status":"online"}
+	}}`), 0o600)
+
+	got, err := generate("kraken", assets, pairs)
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	for _, want := range []string{
+		`ONEINCH Asset = "1INCH"`,
+		`TUSD    Asset = "TUSD"`,
+		`TUSDPair AssetPair = "TUSD"`,
+		`TUSDPair: {Altname: "TUSD", WSName: "T/USD"`,
+		`OrderMin: "130"`,
+	} {
+		if !bytes.Contains(got, []byte(want)) {
+			t.Errorf("generate() does not contain %q:\n%s", want, got)
+		}
+	}
+
+	_ = os.WriteFile(pairs, []byte(`{"error":["EGeneral:Internal error"]}`), 0o600)
+	if _, err := generate("kraken", assets, pairs); err == nil {
+		t.Errorf("generate() error = nil, want error for an error response")
+	}
+}
+
+// Test_generate_upToDate fails when the generated constants do not match the snapshots in testdata.
+func Test_generate_upToDate(t *testing.T) {
+	root := filepath.Join("..", "..", "..")
+
+	got, err := generate("kraken",
+		filepath.Join(root, "testdata", "asset_info.json"),
+		filepath.Join(root, "testdata", "asset_pairs.json"),
+	)
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+
+	want, err := os.ReadFile(filepath.Join(root, "assets_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Errorf("assets_gen.go is out of date, run go generate ./...")
+	}
+}
@@ -0,0 +1,162 @@
+package kraken
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Permission defines a permission granted to a Kraken API key.
+// Docs: https://support.kraken.com/hc/en-us/articles/360000919966-How-to-create-an-API-key
+type Permission string
+
+const (
+	// QueryFunds allows querying the balances and trade volume.
+	QueryFunds Permission = "query-funds"
+	// DepositFunds allows retrieving deposit methods and addresses.
+	DepositFunds Permission = "deposit-funds"
+	// WithdrawFunds allows withdrawing funds.
+	WithdrawFunds Permission = "withdraw-funds"
+	// EarnFunds allows allocating and deallocating funds to earn strategies.
+	EarnFunds Permission = "earn-funds"
+	// QueryOpenOrders allows querying open orders and positions.
+	QueryOpenOrders Permission = "query-open-orders"
+	// QueryClosedOrders allows querying closed orders and trades.
+	QueryClosedOrders Permission = "query-closed-orders"
+	// ModifyOrders allows placing and editing orders.
+	ModifyOrders Permission = "modify-orders"
+	// CancelOrders allows cancelling orders.
+	CancelOrders Permission = "cancel-orders"
+	// QueryLedger allows querying the ledger entries.
+	QueryLedger Permission = "query-ledger"
+	// ExportData allows requesting and retrieving data exports.
+	ExportData Permission = "export-data"
+	// AccessWebsockets allows retrieving the token of the authenticated WebSocket API.
+	AccessWebsockets Permission = "access-websockets"
+)
+
+// endpointPermissions defines the permission required by each private endpoint.
+var endpointPermissions = map[string]Permission{
+	"Balance":                 QueryFunds,
+	"BalanceEx":               QueryFunds,
+	"TradeBalance":            QueryFunds,
+	"OpenOrders":              QueryOpenOrders,
+	"QueryOrders":             QueryOpenOrders,
+	"OpenPositions":           QueryOpenOrders,
+	"ClosedOrders":            QueryClosedOrders,
+	"TradesHistory":           QueryClosedOrders,
+	"QueryTrades":             QueryClosedOrders,
+	"TradeVolume":             QueryFunds,
+	"Ledgers":                 QueryLedger,
+	"QueryLedgers":            QueryLedger,
+	"AddOrder":                ModifyOrders,
+	"AddOrderBatch":           ModifyOrders,
+	"EditOrder":               ModifyOrders,
+	"CancelOrder":             CancelOrders,
+	"CancelOrderBatch":        CancelOrders,
+	"CancelAll":               CancelOrders,
+	"CancelAllOrdersAfter":    CancelOrders,
+	"GetWebSocketsToken":      AccessWebsockets,
+	"CreateSubaccount":        WithdrawFunds,
+	"AccountTransfer":         WithdrawFunds,
+	"Earn/Allocate":           EarnFunds,
+	"Earn/Deallocate":         EarnFunds,
+	"Earn/AllocationStatus":   QueryFunds,
+	"Earn/DeallocationStatus": QueryFunds,
+	"Earn/Strategies":         QueryFunds,
+	"Earn/Allocations":        QueryFunds,
+}
+
+// KeyConfig configures one of the API keys of a client.
+// Kraken tracks nonces and rate limits per API key, so each key has its own
+// nonce source and rate limiter.
+type KeyConfig struct {
+	Name        string
+	Permissions []Permission
+
+	// Credentials provides the API key and secret. Secrets can be used for static credentials.
+	Credentials CredentialsProvider
+	// Key and Signer sign requests without loading the secret, they take precedence over Credentials.
+	Key    APIKey
+	Signer RequestSigner
+
+	NonceSource NonceSource  // Defaults to a MonotonicNonceSource.
+	RateLimiter *RateLimiter // Optional limiter of the call counter of the key.
+}
+
+// ErrNoKeyForEndpoint is returned when no API key of the client has the permission required by an endpoint.
+var ErrNoKeyForEndpoint = errors.New("no API key with the permission required by the endpoint")
+
+// apiKeyState holds the credentials of an API key and the state Kraken binds to it.
+type apiKeyState struct {
+	name        string
+	permissions map[Permission]bool
+	auth        keySigner
+	nonceSource NonceSource
+	rateLimiter *RateLimiter
+	requests    chan struct{} // Serializes the requests of the key when set.
+}
+
+// WithKey adds an API key to the client. Private requests are routed to the first key,
+// in the order they were added, that has the permission required by the endpoint,
+// e.g. Balance to a read-only key and AddOrder to a trading key. Endpoints that no key
+// can serve use the key set with WithAuth, WithSigner or WithCredentials.
+func (c *Client) WithKey(k KeyConfig) *Client {
+	state := &apiKeyState{
+		name:        k.Name,
+		permissions: map[Permission]bool{},
+		nonceSource: k.NonceSource,
+		rateLimiter: k.RateLimiter,
+	}
+
+	for _, p := range k.Permissions {
+		state.permissions[p] = true
+	}
+
+	switch {
+	case k.Signer != nil:
+		state.auth = staticKeySigner{key: k.Key, signer: k.Signer}
+	case k.Credentials != nil:
+		state.auth = &providerKeySigner{provider: k.Credentials}
+	default:
+		state.auth = staticKeySigner{err: fmt.Errorf("API key %q has no credentials", k.Name)}
+	}
+
+	if state.nonceSource == nil {
+		state.nonceSource = NewMonotonicNonceSource()
+	}
+
+	if c.privateRequests != nil {
+		state.requests = make(chan struct{}, 1)
+	}
+
+	c.keys = append(c.keys, state)
+
+	return c
+}
+
+// keyFor returns the API key used to sign requests to the endpoint.
+func (c *Client) keyFor(endpoint string) (*apiKeyState, error) {
+	if p, ok := endpointPermissions[endpoint]; ok {
+		for _, k := range c.keys {
+			if k.permissions[p] {
+				return k, nil
+			}
+		}
+	}
+
+	if c.auth == nil && len(c.keys) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoKeyForEndpoint, endpoint)
+	}
+
+	auth := c.auth
+	if auth == nil {
+		auth = staticKeySigner{signer: Signer{}}
+	}
+
+	return &apiKeyState{
+		auth:        auth,
+		nonceSource: c.nonceSource,
+		rateLimiter: c.rateLimiter,
+		requests:    c.privateRequests,
+	}, nil
+}
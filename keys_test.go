@@ -0,0 +1,173 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type counterNonceSource struct {
+	last uint64
+}
+
+func (n *counterNonceSource) Nonce() (uint64, error) {
+	n.last++
+	return n.last, nil
+}
+
+func TestClient_WithKey(t *testing.T) {
+	ctx := context.Background()
+
+	secret := "kQH5HW/8p1uGOVjbgWA7FunAmGO8lsSUXNsu3eow76sz84Q18fWxnyRzBHCd3pd5nE9qa99HAZtuZuj6F1huXg=="
+
+	queryNonces := &counterNonceSource{}
+	tradeNonces := &counterNonceSource{last: 100}
+
+	newClient := func(apiMock *httptest.Server) *Client {
+		baseURL, _ := url.Parse(apiMock.URL + "/")
+
+		c := New(apiMock.Client()).
+			WithKey(KeyConfig{
+				Name:        "query",
+				Permissions: []Permission{QueryFunds},
+				Credentials: Secrets{Key: "query-key", Secret: secret},
+				NonceSource: queryNonces,
+			}).
+			WithKey(KeyConfig{
+				Name:        "trade",
+				Permissions: []Permission{ModifyOrders, CancelOrders},
+				Key:         "trade-key",
+				Signer:      fakeSigner{signature: "signature"},
+				NonceSource: tradeNonces,
+			}).
+			WithKey(KeyConfig{
+				Name:        "funding",
+				Permissions: []Permission{WithdrawFunds},
+				Credentials: Secrets{Key: "funding-key", Secret: secret},
+			})
+		c.baseURL = baseURL
+
+		return c
+	}
+
+	tests := []struct {
+		name      string
+		res       string
+		call      func(c *Client) error
+		wantKey   string
+		wantNonce string
+		wantErr   error
+	}{
+		{
+			name: "balance uses the query key",
+			res:  "account_balance.json",
+			call: func(c *Client) error {
+				_, err := c.Account.Balance(ctx)
+				return err
+			},
+			wantKey:   "query-key",
+			wantNonce: "1",
+		},
+		{
+			name: "add order uses the trade key",
+			res:  "add_order.json",
+			call: func(c *Client) error {
+				_, err := c.Trading.AddOrder(ctx, AddOrderOpts{Pair: "XXBTZUSD"})
+				return err
+			},
+			wantKey:   "trade-key",
+			wantNonce: "101",
+		},
+		{
+			name: "transfer uses the funding key",
+			res:  "transfer.json",
+			call: func(c *Client) error {
+				_, err := c.Subaccounts.Transfer(ctx, TransferOpts{Asset: "XBT", Amount: "1", From: "a", To: "b"})
+				return err
+			},
+			wantKey: "funding-key",
+		},
+		{
+			name: "no key for the endpoint",
+			res:  "ws_auth.json",
+			call: func(c *Client) error {
+				_, err := c.WebsocketsAuth.WebsocketsToken(ctx)
+				return err
+			},
+			wantErr: ErrNoKeyForEndpoint,
+		},
+		{
+			name: "default key for the endpoint",
+			res:  "ws_auth.json",
+			call: func(c *Client) error {
+				_, err := c.WithAuth(Secrets{Key: "default-key", Secret: secret}).WebsocketsAuth.WebsocketsToken(ctx)
+				return err
+			},
+			wantKey: "default-key",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var key, nonce string
+
+			apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				key = r.Header.Get("API-Key")
+				nonce = r.PostForm.Get("nonce")
+				http.ServeFile(w, r, "testdata/"+tt.res)
+			}))
+			defer apiMock.Close()
+
+			err := tt.call(newClient(apiMock))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("call error = %v, want %v", err, tt.wantErr)
+			}
+			if key != tt.wantKey {
+				t.Errorf("API-Key = %v, want %v", key, tt.wantKey)
+			}
+			if tt.wantNonce != "" && nonce != tt.wantNonce {
+				t.Errorf("nonce = %v, want %v", nonce, tt.wantNonce)
+			}
+		})
+	}
+}
+
+func TestClient_WithKey_rateLimiter(t *testing.T) {
+	apiMock := createFakeServer(http.StatusOK, "account_balance.json")
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	l := NewRateLimiter(StarterTier, FailOnRateLimit)
+
+	c := New(apiMock.Client()).
+		WithRateLimiter(NewRateLimiter(StarterTier, FailOnRateLimit)).
+		WithKey(KeyConfig{
+			Name:        "query",
+			Permissions: []Permission{QueryFunds},
+			Credentials: Secrets{Key: "key", Secret: "c2VjcmV0"},
+			RateLimiter: l,
+		})
+	c.baseURL = baseURL
+
+	if _, err := c.Account.Balance(context.Background()); err != nil {
+		t.Fatalf("Account.Balance() error = %v", err)
+	}
+	if got := l.Counter(); got < 0.99 {
+		t.Errorf("RateLimiter.Counter() = %v, want the key limiter to be used", got)
+	}
+	if got := c.rateLimiter.Counter(); got != 0 {
+		t.Errorf("RateLimiter.Counter() = %v, want the default limiter not to be used", got)
+	}
+}
+
+func TestClient_WithKey_noCredentials(t *testing.T) {
+	c := New(nil).WithKey(KeyConfig{Name: "query", Permissions: []Permission{QueryFunds}})
+
+	_, err := c.Account.Balance(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "query") {
+		t.Errorf("Account.Balance() error = %v, want missing credentials error", err)
+	}
+}
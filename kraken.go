@@ -3,6 +3,7 @@ package kraken
 import (
 	"net/http"
 	"net/url"
+	"strings"
 )
 
 const (
@@ -14,8 +15,8 @@ const (
 
 // Secrets represents the Kraken API key and secret.
 type Secrets struct {
-	Key    string
-	Secret string
+	Key    string `json:"key"`
+	Secret string `json:"secret"`
 }
 
 // A Client manages communication with the Mercedes API.
@@ -26,9 +27,27 @@ type Client struct {
 	//BaseURL should always be specified with a trailing slash.
 	baseURL *url.URL
 
-	apiKey APIKey // API key used for authentication.
+	auth keySigner // API key and signer used to sign API requests.
 
-	signer Signer // Signer used to sign API requests.
+	keys []*apiKeyState // Additional API keys, routed by permission.
+
+	nonceSource NonceSource // Source of the nonces of private requests.
+
+	otpProvider OtpProvider // Optional provider of one-time passwords.
+
+	privateRequests chan struct{} // Serializes private requests when set.
+
+	rateLimiter *RateLimiter // Optional limiter of the REST API call counter.
+
+	tradingRateLimiter *TradingRateLimiter // Optional limiter of the matching engine counters.
+
+	retryPolicy RetryPolicy // Policy used to retry failed requests. By default, requests are not retried.
+
+	resolver *Resolver // Optional resolver of the asset pair names of responses.
+
+	orderValidator *OrderValidator // Optional validator of the orders placed.
+
+	middlewares []endpointMiddleware // Middlewares wrapping the calls, see WithMiddleware.
 
 	common service // Reuse a single struct instead of allocating one for each service on the heap.
 
@@ -55,8 +74,9 @@ func New(httpClient *http.Client) *Client {
 	}
 
 	c := &Client{
-		baseURL: baseURL,
-		client:  httpClient,
+		baseURL:     baseURL,
+		client:      httpClient,
+		nonceSource: NewMonotonicNonceSource(),
 	}
 
 	c.common.client = c
@@ -71,10 +91,100 @@ func New(httpClient *http.Client) *Client {
 	return c
 }
 
+// WithBaseURL sets the base URL of API requests, including the API version,
+// e.g. https://api.kraken.com/0/. It's mainly useful to test against a fake server,
+// see the krakentest package. A trailing slash is added if missing.
+func (c *Client) WithBaseURL(u *url.URL) *Client {
+	baseURL := *u
+	if !strings.HasSuffix(baseURL.Path, "/") {
+		baseURL.Path += "/"
+	}
+
+	c.baseURL = &baseURL
+
+	return c
+}
+
 // WithAuth sets the Kraken API key and secret.
+// If the secret is not valid, private requests fail with the decoding error.
 func (c *Client) WithAuth(s Secrets) *Client {
-	c.apiKey = APIKey(s.Key)
-	c.signer = NewSigner(s.Secret)
+	signer, err := NewSigner(s.Secret)
+
+	c.auth = staticKeySigner{key: APIKey(s.Key), signer: signer, err: err}
+
+	return c
+}
+
+// WithSigner sets the Kraken API key and the signer used to sign private requests,
+// so that the API secret does not need to be loaded in the process memory.
+func (c *Client) WithSigner(key APIKey, s RequestSigner) *Client {
+	c.auth = staticKeySigner{key: key, signer: s}
+
+	return c
+}
+
+// WithCredentials sets the provider of the Kraken API key and secret.
+// Credentials are requested before signing each private request, so keys can be
+// rotated at runtime, e.g. with a RefreshingCredentials provider. The decoded secret
+// of rotated keys is zeroed once they are no longer used.
+func (c *Client) WithCredentials(p CredentialsProvider) *Client {
+	c.auth = &providerKeySigner{provider: p}
+
+	return c
+}
+
+// WithRateLimiter sets the limiter used to keep private calls within the
+// Kraken API call counter. By default, calls are not rate limited.
+// Keys added with WithKey have their own limiter, see KeyConfig.
+func (c *Client) WithRateLimiter(l *RateLimiter) *Client {
+	c.rateLimiter = l
+
+	return c
+}
+
+// WithTradingRateLimiter sets the limiter used to keep order placement and
+// cancellation within the per-pair matching engine limits.
+func (c *Client) WithTradingRateLimiter(l *TradingRateLimiter) *Client {
+	c.tradingRateLimiter = l
+
+	return c
+}
+
+// WithRetryPolicy sets the policy used to retry requests that failed with a transient error.
+// Only public and read-only private requests are retried, AddOrder is only retried
+// when a client order ID is set so that Kraken can reject duplicated orders.
+func (c *Client) WithRetryPolicy(p RetryPolicy) *Client {
+	c.retryPolicy = p
+
+	return c
+}
+
+// WithNonceSource sets the source of the nonces of private requests.
+// By default, nonces are strictly increasing timestamps in nanoseconds.
+func (c *Client) WithNonceSource(ns NonceSource) *Client {
+	c.nonceSource = ns
+
+	return c
+}
+
+// WithSerializedRequests sends the private requests of each API key one at a time, so that they reach
+// Kraken in the same order their nonces were generated. It avoids "EAPI:Invalid nonce"
+// errors when the client is shared by several goroutines, at the cost of throughput.
+func (c *Client) WithSerializedRequests() *Client {
+	c.privateRequests = make(chan struct{}, 1)
+
+	for _, k := range c.keys {
+		k.requests = make(chan struct{}, 1)
+	}
+
+	return c
+}
+
+// WithOtpProvider sets the provider of the one-time password added to every private request,
+// e.g. a TOTP generator for API keys protected by 2FA. A one-time password set in the
+// context with ContextWithOtp overrides the provider.
+func (c *Client) WithOtpProvider(p OtpProvider) *Client {
+	c.otpProvider = p
 
 	return c
 }
@@ -0,0 +1,80 @@
+package krakentest
+
+import (
+	"net/http"
+	"time"
+)
+
+// Fault represents an error or a delay injected in the responses of an endpoint.
+type Fault struct {
+	// Errors are the Kraken messages returned instead of the result, e.g. "EService:Unavailable".
+	Errors []string
+	// StatusCode of the responses with errors. It defaults to 200, as Kraken reports most errors with it.
+	StatusCode int
+	// Latency delays the responses of the endpoint.
+	Latency time.Duration
+	// Times is the number of requests affected by the fault, every request when zero.
+	Times int
+}
+
+// Inject adds a fault to the responses of the endpoint, e.g. "AddOrder" or "Ticker".
+// The faults of an endpoint are applied in the order they were injected, each one
+// once the requests of the previous one are exhausted.
+func (s *Server) Inject(endpoint string, f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults[endpoint] = append(s.faults[endpoint], &f)
+}
+
+// ClearFaults removes every fault injected.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.faults = make(map[string][]*Fault)
+}
+
+// applyFault applies the next fault of the endpoint. It returns true if the request
+// was answered with the errors of the fault or cancelled while delayed.
+func (s *Server) applyFault(w http.ResponseWriter, r *http.Request, endpoint string) bool {
+	s.mu.Lock()
+	faults := s.faults[endpoint]
+	if len(faults) == 0 {
+		s.mu.Unlock()
+		return false
+	}
+
+	f := *faults[0]
+	if faults[0].Times > 0 {
+		faults[0].Times--
+		if faults[0].Times == 0 {
+			s.faults[endpoint] = faults[1:]
+		}
+	}
+	s.mu.Unlock()
+
+	if f.Latency > 0 {
+		t := time.NewTimer(f.Latency)
+		defer t.Stop()
+
+		select {
+		case <-r.Context().Done():
+			return true
+		case <-t.C:
+		}
+	}
+
+	if len(f.Errors) == 0 {
+		return false
+	}
+
+	status := f.StatusCode
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	writeResponse(w, status, response{Error: f.Errors})
+
+	return true
+}
@@ -0,0 +1,59 @@
+package krakentest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+func TestServer_Inject(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testServer(t, kraken.StarterTier)
+	c := srv.NewClient(srv.NewKey())
+
+	srv.Inject("Balance", Fault{Errors: []string{"EService:Unavailable"}, Times: 1})
+	srv.Inject("Balance", Fault{Errors: []string{"EGeneral:Internal error"}, StatusCode: http.StatusInternalServerError, Times: 1})
+
+	if _, err := c.Account.Balance(ctx); !errors.Is(err, kraken.ErrServiceUnavailable) {
+		t.Errorf("Account.Balance() error = %v, want %v", err, kraken.ErrServiceUnavailable)
+	}
+
+	_, err := c.Account.Balance(ctx)
+	var krakenErr *kraken.Error
+	if !errors.As(err, &krakenErr) || krakenErr.StatusCode != http.StatusInternalServerError {
+		t.Errorf("Account.Balance() error = %v, want a %d error", err, http.StatusInternalServerError)
+	}
+
+	if _, err := c.Account.Balance(ctx); err != nil {
+		t.Errorf("Account.Balance() error = %v, want the faults exhausted", err)
+	}
+
+	if got := srv.Calls("Balance"); got != 3 {
+		t.Errorf("Server.Calls() = %v, want %v", got, 3)
+	}
+}
+
+func TestServer_Inject_latency(t *testing.T) {
+	srv := testServer(t, kraken.StarterTier)
+	c := kraken.New(srv.Client()).WithBaseURL(srv.BaseURL())
+
+	srv.Inject("Time", Fault{Latency: time.Minute})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, err := c.Market.Time(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("MarketData.Time() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	srv.ClearFaults()
+
+	if _, err := c.Market.Time(context.Background()); err != nil {
+		t.Errorf("MarketData.Time() error = %v", err)
+	}
+}
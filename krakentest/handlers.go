@@ -0,0 +1,263 @@
+package krakentest
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+// handler serves an endpoint, returning its result or the error reported to the client.
+type handler func(s *Server, r *http.Request) (any, error)
+
+var publicHandlers = map[string]handler{
+	"Time":         (*Server).serverTime,
+	"SystemStatus": (*Server).systemStatus,
+	"Assets":       (*Server).assets,
+	"AssetPairs":   (*Server).assetPairs,
+	"Ticker":       (*Server).ticker,
+	"Depth":        (*Server).depth,
+}
+
+var privateHandlers = map[string]handler{
+	"Balance":              (*Server).balance,
+	"BalanceEx":            (*Server).extendedBalance,
+	"OpenOrders":           (*Server).openOrders,
+	"AddOrder":             (*Server).addOrder,
+	"CancelOrder":          (*Server).cancelOrder,
+	"CancelAll":            (*Server).cancelAll,
+	"CancelAllOrdersAfter": (*Server).cancelAllOrdersAfter,
+}
+
+func (s *Server) serverTime(r *http.Request) (any, error) {
+	return s.exchange.Time(r.Context())
+}
+
+func (s *Server) systemStatus(r *http.Request) (any, error) {
+	return s.exchange.SystemStatus(r.Context())
+}
+
+func (s *Server) assets(r *http.Request) (any, error) {
+	var opts kraken.AssetsOpts
+	for _, a := range list(r.Form, "asset") {
+		opts.Assets = append(opts.Assets, kraken.Asset(a))
+	}
+
+	return s.exchange.Assets(r.Context(), opts)
+}
+
+func (s *Server) assetPairs(r *http.Request) (any, error) {
+	return s.exchange.TradableAssetPairs(r.Context(), kraken.TradableAssetPairsOpts{Pairs: pairs(r.Form)})
+}
+
+func (s *Server) ticker(r *http.Request) (any, error) {
+	return s.exchange.TickerInformation(r.Context(), kraken.TickerInformationOpts{Pairs: pairs(r.Form)})
+}
+
+func (s *Server) depth(r *http.Request) (any, error) {
+	opts := kraken.OrderBookOpts{Pair: kraken.AssetPair(r.Form.Get("pair"))}
+
+	if v := r.Form.Get("count"); v != "" {
+		count, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, invalidArgument("count")
+		}
+		opts.Count = count
+	}
+
+	pair, ok := s.resolver.Pair(string(opts.Pair))
+	if !ok {
+		return nil, kraken.ErrUnknownAssetPair
+	}
+
+	ob, err := s.exchange.OrderBook(r.Context(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[kraken.AssetPair]*kraken.OrderBook{pair: ob}, nil
+}
+
+func (s *Server) balance(r *http.Request) (any, error) {
+	return s.exchange.Balance(r.Context())
+}
+
+func (s *Server) extendedBalance(r *http.Request) (any, error) {
+	return s.exchange.ExtendedBalance(r.Context())
+}
+
+func (s *Server) openOrders(r *http.Request) (any, error) {
+	return s.exchange.OpenOrders(r.Context(), kraken.OpenOrdersOpts{
+		UserRef:       r.PostForm.Get("userref"),
+		ClientOrderID: r.PostForm.Get("cl_ord_id"),
+	})
+}
+
+func (s *Server) addOrder(r *http.Request) (any, error) {
+	opts, err := addOrderOpts(r.PostForm)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validated orders are not sent to the matching engine.
+	pair, ok := s.resolver.Pair(opts.Pair)
+	if !ok || opts.Validate {
+		return s.exchange.AddOrder(r.Context(), opts)
+	}
+
+	if err := s.trading.WaitAddOrder(r.Context(), pair); err != nil {
+		return nil, err
+	}
+
+	v, err := s.exchange.AddOrder(r.Context(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	s.trading.TrackOrders(pair, v.Transaction...)
+
+	return v, nil
+}
+
+func (s *Server) cancelOrder(r *http.Request) (any, error) {
+	txid := kraken.TransactionID(r.PostForm.Get("txid"))
+
+	if err := s.trading.WaitCancelOrder(r.Context(), txid); err != nil {
+		return nil, err
+	}
+
+	v, err := s.exchange.CancelOrder(r.Context(), kraken.CancelOrderOpts{TransactionID: string(txid)})
+	if err != nil {
+		return nil, err
+	}
+
+	s.trading.ForgetOrders(txid)
+
+	return v, nil
+}
+
+func (s *Server) cancelAll(r *http.Request) (any, error) {
+	open, err := s.exchange.OpenOrders(r.Context(), kraken.OpenOrdersOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	v, err := s.exchange.CancelAllOrders(r.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	for txid := range open.Open {
+		s.trading.ForgetOrders(txid)
+	}
+
+	return v, nil
+}
+
+func (s *Server) cancelAllOrdersAfter(r *http.Request) (any, error) {
+	timeout, err := strconv.ParseInt(r.PostForm.Get("timeout"), 10, 64)
+	if err != nil || timeout < 0 {
+		return nil, invalidArgument("timeout")
+	}
+
+	return s.exchange.CancelAllOrdersAfter(r.Context(), kraken.CancelAllOrdersAfterOpts{
+		Timeout: time.Duration(timeout) * time.Second,
+	})
+}
+
+// addOrderOpts decodes the parameters of an order, as encoded by kraken.Trading.AddOrder.
+func addOrderOpts(f url.Values) (kraken.AddOrderOpts, error) {
+	opts := kraken.AddOrderOpts{
+		UserRef:        f.Get("userref"),
+		ClientOrderID:  f.Get("cl_ord_id"),
+		OrderType:      kraken.OrderType(f.Get("ordertype")),
+		Type:           kraken.OrderDirection(f.Get("type")),
+		Volume:         kraken.Decimal(f.Get("volume")),
+		DisplayVol:     kraken.Decimal(f.Get("displayvol")),
+		Pair:           f.Get("pair"),
+		Price:          kraken.OrderPrice(f.Get("price")),
+		Price2:         kraken.OrderPrice(f.Get("price2")),
+		Trigger:        kraken.OrderTrigger(f.Get("trigger")),
+		Leverage:       f.Get("leverage"),
+		ReduceOnly:     f.Get("reduce_only") == "true",
+		StopType:       kraken.StopType(f.Get("stptype")),
+		TimeInForce:    kraken.TimeInForce(f.Get("timeinforce")),
+		CloseOrderType: kraken.OrderType(f.Get("close[ordertype]")),
+		ClosePrice:     kraken.OrderPrice(f.Get("close[price]")),
+		ClosePrice2:    kraken.OrderPrice(f.Get("close[price2]")),
+		Validate:       f.Get("validate") == "true",
+	}
+
+	for _, flag := range list(f, "oflags") {
+		opts.OrderFlags = append(opts.OrderFlags, kraken.OrderFlag(flag))
+	}
+
+	var err error
+	if opts.Starttm, err = orderTime(f.Get("starttm")); err != nil {
+		return opts, invalidArgument("starttm")
+	}
+	if opts.Expiretm, err = orderTime(f.Get("expiretm")); err != nil {
+		return opts, invalidArgument("expiretm")
+	}
+
+	if v := f.Get("deadline"); v != "" {
+		deadline, err := time.Parse(time.RFC3339Nano, v)
+		if err != nil {
+			return opts, invalidArgument("deadline")
+		}
+		opts.Deadline = kraken.OrderDeadline{Time: deadline}
+	}
+
+	return opts, nil
+}
+
+// orderTime decodes a start or expiration time: a unix timestamp, an offset
+// in seconds prefixed with "+", or zero for none.
+func orderTime(v string) (kraken.OrderTime, error) {
+	if v == "" || v == "0" {
+		return kraken.OrderTime{}, nil
+	}
+
+	if offset, ok := strings.CutPrefix(v, "+"); ok {
+		seconds, err := strconv.ParseInt(offset, 10, 64)
+		if err != nil {
+			return kraken.OrderTime{}, err
+		}
+		return kraken.OrderTimeIn(time.Duration(seconds) * time.Second), nil
+	}
+
+	unix, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return kraken.OrderTime{}, err
+	}
+	return kraken.OrderTimeAt(time.Unix(unix, 0)), nil
+}
+
+// list returns the values of a parameter, either repeated or separated by commas.
+func list(f url.Values, key string) []string {
+	var values []string
+	for _, v := range f[key] {
+		for _, item := range strings.Split(v, ",") {
+			if item != "" {
+				values = append(values, item)
+			}
+		}
+	}
+	return values
+}
+
+func pairs(f url.Values) []kraken.AssetPair {
+	var v []kraken.AssetPair
+	for _, p := range list(f, "pair") {
+		v = append(v, kraken.AssetPair(p))
+	}
+	return v
+}
+
+// invalidArgument returns the error reported for an invalid parameter, e.g. "EGeneral:Invalid arguments:volume".
+func invalidArgument(name string) error {
+	return kraken.ParseAPIError(kraken.ErrInvalidArguments.Error() + ":" + name)
+}
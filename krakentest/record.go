@@ -0,0 +1,294 @@
+package krakentest
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// redacted replaces the credentials and single use values of recorded requests.
+const redacted = "REDACTED"
+
+// ErrNoInteraction is returned by a Replayer for requests that were not recorded.
+var ErrNoInteraction = errors.New("no recorded interaction matches the request")
+
+// redactedHeaders are the request headers holding credentials.
+var redactedHeaders = []string{"API-Key", "API-Sign", "Authorization", "Cookie"}
+
+// ignoredParams are the parameters that change on every request. They are redacted
+// when recording and ignored when matching requests.
+var ignoredParams = []string{"nonce", "otp"}
+
+// Interaction represents a request to the Kraken API and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest represents a recorded request, with its credentials redacted.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	// Endpoint is the path of the request relative to the API version, e.g. "private/AddOrder".
+	Endpoint string `json:"endpoint"`
+	// Params holds the query and body parameters of the request.
+	Params url.Values  `json:"params,omitempty"`
+	Header http.Header `json:"header,omitempty"`
+}
+
+// RecordedResponse represents a recorded response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// cassette is the content of a fixture file.
+type cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Recorder is an http.RoundTripper that records the requests sent through it and their responses
+// to a fixture file, to be replayed with a Replayer. The API-Key and API-Sign headers, nonces and
+// one-time passwords are redacted. It is safe for concurrent use.
+//
+//	c := kraken.New(&http.Client{Transport: krakentest.NewRecorder("testdata/balance.json", nil)})
+type Recorder struct {
+	transport http.RoundTripper
+	path      string
+
+	mu       sync.Mutex
+	cassette cassette
+}
+
+// NewRecorder returns a new Recorder writing to the fixture file at path, which is overwritten.
+// Requests are sent with the given transport, or http.DefaultTransport if nil.
+func NewRecorder(path string, transport http.RoundTripper) *Recorder {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	return &Recorder{transport: transport, path: path}
+}
+
+// RoundTrip sends the request and records it together with its response.
+// The fixture file is written after every interaction.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, req, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := res.Header.Clone()
+	header.Del("Set-Cookie")
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request:  recorded,
+		Response: RecordedResponse{StatusCode: res.StatusCode, Header: header, Body: string(body)},
+	})
+
+	d, err := json.MarshalIndent(r.cassette, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	// Fixtures hold account data, so they are only readable by their owner.
+	if err := os.WriteFile(r.path, d, 0o600); err != nil {
+		return nil, fmt.Errorf("writing fixture: %w", err)
+	}
+
+	return res, nil
+}
+
+// Replayer is an http.RoundTripper that answers requests with the responses recorded by a Recorder,
+// without network access. Requests are matched by method, endpoint and parameters, ignoring nonces
+// and one-time passwords. Identical requests are answered with their responses in the order they were
+// recorded, the last one being repeated once exhausted. It is safe for concurrent use.
+type Replayer struct {
+	mu           sync.Mutex
+	interactions []Interaction
+	replayed     []bool
+}
+
+// NewReplayer returns a new Replayer of the fixture file at path.
+func NewReplayer(path string) (*Replayer, error) {
+	d, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c cassette
+	if err := json.Unmarshal(d, &c); err != nil {
+		return nil, fmt.Errorf("decoding fixture %s: %w", path, err)
+	}
+
+	return &Replayer{interactions: c.Interactions, replayed: make([]bool, len(c.Interactions))}, nil
+}
+
+// RoundTrip returns the recorded response of the request, or ErrNoInteraction if it was not recorded.
+func (r *Replayer) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded, req, err := recordRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	key := matchKey(recorded)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	last := -1
+	for i, in := range r.interactions {
+		if matchKey(in.Request) != key {
+			continue
+		}
+		last = i
+		if !r.replayed[i] {
+			break
+		}
+	}
+
+	if last < 0 {
+		return nil, fmt.Errorf("%w: %s %s", ErrNoInteraction, recorded.Method, recorded.Endpoint)
+	}
+	r.replayed[last] = true
+
+	res := r.interactions[last].Response
+
+	header := res.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", res.StatusCode, http.StatusText(res.StatusCode)),
+		StatusCode:    res.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(res.Body)),
+		ContentLength: int64(len(res.Body)),
+		Request:       req,
+	}, nil
+}
+
+// recordRequest returns the request as recorded, with its credentials redacted, and the
+// request to send. The request is not modified: when its body is read, a copy holding
+// the body read is returned instead.
+func recordRequest(req *http.Request) (RecordedRequest, *http.Request, error) {
+	params := req.URL.Query()
+
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return RecordedRequest{}, nil, err
+		}
+
+		req = req.Clone(req.Context())
+		req.Body = io.NopCloser(bytes.NewReader(body))
+
+		if err := bodyParams(req.Header.Get("Content-Type"), body, params); err != nil {
+			return RecordedRequest{}, nil, err
+		}
+	}
+
+	for _, p := range ignoredParams {
+		if params.Has(p) {
+			params.Set(p, redacted)
+		}
+	}
+
+	header := req.Header.Clone()
+	for _, h := range redactedHeaders {
+		if header.Get(h) != "" {
+			header.Set(h, redacted)
+		}
+	}
+
+	if len(params) == 0 {
+		params = nil
+	}
+
+	return RecordedRequest{
+		Method:   req.Method,
+		Endpoint: endpoint(req.URL.Path),
+		Params:   params,
+		Header:   header,
+	}, req, nil
+}
+
+// bodyParams adds the parameters of a form or JSON encoded body to params.
+// Values of JSON bodies that are not strings are kept JSON encoded.
+func bodyParams(contentType string, body []byte, params url.Values) error {
+	if len(body) == 0 {
+		return nil
+	}
+
+	if strings.HasPrefix(contentType, "application/json") {
+		var m map[string]json.RawMessage
+		if err := json.Unmarshal(body, &m); err != nil {
+			return err
+		}
+		for k, raw := range m {
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				s = string(raw)
+			}
+			params.Add(k, s)
+		}
+		return nil
+	}
+
+	form, err := url.ParseQuery(string(body))
+	if err != nil {
+		return err
+	}
+	for k, v := range form {
+		params[k] = append(params[k], v...)
+	}
+	return nil
+}
+
+// endpoint returns the path relative to the API version, e.g. "private/AddOrder" for "/0/private/AddOrder".
+func endpoint(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+	if len(parts) > 2 {
+		parts = parts[len(parts)-2:]
+	}
+	return strings.Join(parts, "/")
+}
+
+// matchKey returns the key requests are matched by, ignoring the parameters that change on every request.
+func matchKey(r RecordedRequest) string {
+	params := url.Values{}
+	for k, v := range r.Params {
+		params[k] = v
+	}
+	for _, p := range ignoredParams {
+		params.Del(p)
+	}
+
+	return r.Method + " " + r.Endpoint + "?" + params.Encode()
+}
@@ -0,0 +1,136 @@
+package krakentest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jferrl/go-kraken"
+)
+
+func TestRecorder(t *testing.T) {
+	ctx := context.Background()
+	fixture := filepath.Join(t.TempDir(), "fixture.json")
+
+	srv := testServer(t, kraken.StarterTier)
+	secrets := srv.NewKey()
+
+	recorder := NewRecorder(fixture, srv.Client().Transport)
+	c := kraken.New(&http.Client{Transport: recorder}).
+		WithBaseURL(srv.BaseURL()).
+		WithAuth(secrets)
+
+	order := kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Market, Volume: "0.5"}
+
+	var recorded []kraken.AccountBalance
+	for i := 0; i < 2; i++ {
+		if _, err := c.Trading.AddOrder(kraken.ContextWithOtp(ctx, "123456"), order); err != nil {
+			t.Fatalf("Trading.AddOrder() error = %v", err)
+		}
+
+		balance, err := c.Account.Balance(ctx)
+		if err != nil {
+			t.Fatalf("Account.Balance() error = %v", err)
+		}
+		recorded = append(recorded, balance)
+	}
+
+	d, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatalf("reading fixture: %v", err)
+	}
+	if fi, err := os.Stat(fixture); err != nil || fi.Mode().Perm() != 0o600 {
+		t.Errorf("fixture mode = %v, %v, want %v", fi.Mode().Perm(), err, os.FileMode(0o600))
+	}
+	for _, secret := range []string{secrets.Key, secrets.Secret, "123456"} {
+		if strings.Contains(string(d), secret) {
+			t.Errorf("fixture contains %q", secret)
+		}
+	}
+
+	replayer, err := NewReplayer(fixture)
+	if err != nil {
+		t.Fatalf("NewReplayer() error = %v", err)
+	}
+
+	// Replayed requests are sent to Kraken, with other credentials and nonces.
+	c = kraken.New(&http.Client{Transport: replayer}).WithAuth(kraken.Secrets{Key: "key", Secret: secrets.Secret})
+
+	var replayed []kraken.AccountBalance
+	for i := 0; i < 3; i++ {
+		if _, err := c.Trading.AddOrder(ctx, order); err != nil {
+			t.Fatalf("Trading.AddOrder() error = %v", err)
+		}
+
+		balance, err := c.Account.Balance(ctx)
+		if err != nil {
+			t.Fatalf("Account.Balance() error = %v", err)
+		}
+		replayed = append(replayed, balance)
+	}
+
+	// The last recorded response is repeated.
+	want := append(recorded, recorded[1])
+	if !reflect.DeepEqual(replayed, want) {
+		t.Errorf("replayed balances = %v, want %v", replayed, want)
+	}
+
+	order.Volume = "1"
+	if _, err := c.Trading.AddOrder(ctx, order); !errors.Is(err, ErrNoInteraction) {
+		t.Errorf("Trading.AddOrder() error = %v, want %v", err, ErrNoInteraction)
+	}
+}
+
+func TestRecorder_RoundTrip_request(t *testing.T) {
+	srv := testServer(t, kraken.StarterTier)
+
+	recorder := NewRecorder(filepath.Join(t.TempDir(), "fixture.json"), srv.Client().Transport)
+
+	body := io.NopCloser(strings.NewReader("pair=XBTUSD"))
+	req, _ := http.NewRequest(http.MethodPost, srv.BaseURL().String()+"public/Ticker", body)
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := recorder.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Recorder.RoundTrip() error = %v", err)
+	}
+	defer res.Body.Close()
+
+	// The request passed to RoundTrip must not be modified.
+	if req.Body != body {
+		t.Errorf("Recorder.RoundTrip() replaced the body of the request")
+	}
+	if res.Request == req {
+		t.Errorf("Recorder.RoundTrip() sent the request instead of a copy")
+	}
+}
+
+func TestNewReplayer_invalidFixture(t *testing.T) {
+	fixture := filepath.Join(t.TempDir(), "fixture.json")
+	if err := os.WriteFile(fixture, []byte("{"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewReplayer(fixture); err == nil {
+		t.Error("NewReplayer() error = nil, want an error")
+	}
+
+	if _, err := NewReplayer(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("NewReplayer() error = %v, want %v", err, os.ErrNotExist)
+	}
+}
+
+func Test_matchKey(t *testing.T) {
+	a := RecordedRequest{Method: http.MethodPost, Endpoint: "private/Balance", Params: map[string][]string{"nonce": {"1"}, "otp": {"2"}}}
+	b := RecordedRequest{Method: http.MethodPost, Endpoint: "private/Balance", Params: map[string][]string{"nonce": {redacted}}}
+
+	if matchKey(a) != matchKey(b) {
+		t.Errorf("matchKey() = %q, want %q", matchKey(a), matchKey(b))
+	}
+}
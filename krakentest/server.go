@@ -0,0 +1,275 @@
+// Package krakentest provides an in-memory stand-in of the Kraken REST API, so that programs
+// using the kraken package can be tested end to end without network access.
+//
+// A Server verifies the API-Key and API-Sign headers and the nonces of private requests as
+// Kraken does, enforces the call counter and matching engine rate limits of the account tier,
+// and keeps balances and orders in a papertrade.Exchange. Errors and latency can be injected
+// in the responses of any endpoint with Inject.
+//
+// Interactions with the real API can also be captured once with a Recorder, and replayed
+// offline with a Replayer.
+//
+//	srv := krakentest.NewServer(krakentest.Config{Balances: kraken.AccountBalance{"ZUSD": "1000"}})
+//	defer srv.Close()
+//
+//	c := srv.NewClient(srv.NewKey())
+package krakentest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/jferrl/go-kraken"
+	"github.com/jferrl/go-kraken/papertrade"
+)
+
+const apiVersion = "0"
+
+// Config represents the configuration of a Server.
+type Config struct {
+	// Assets and Pairs traded by the server. They default to kraken.KnownAssets and
+	// kraken.KnownAssetPairs, which hold no fee schedules, so orders are not charged fees.
+	Assets kraken.Assets
+	Pairs  kraken.AssetPairs
+
+	// Balances of the account, keyed by asset name, e.g. XXBT and ZUSD.
+	Balances kraken.AccountBalance
+
+	// Tier of the account, selecting the call counter limits of the API keys
+	// and the matching engine limits of the account.
+	Tier kraken.Tier
+}
+
+// Server is a fake Kraken REST API server. It is safe for concurrent use.
+// Every API key created with NewKey trades on the same account.
+type Server struct {
+	*httptest.Server
+
+	exchange *papertrade.Exchange
+	resolver *kraken.Resolver
+	tier     kraken.Tier
+	trading  *kraken.TradingRateLimiter // Matching engine limits, shared by the keys of the account.
+
+	mu     sync.Mutex
+	keys   map[string]*apiKey
+	faults map[string][]*Fault
+	calls  map[string]int
+}
+
+// apiKey holds the state Kraken keeps for an API key.
+type apiKey struct {
+	signer  kraken.Signer
+	nonce   uint64 // Last nonce used, the nonce of every request must be greater.
+	limiter *kraken.RateLimiter
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to shut it down.
+func NewServer(cfg Config) *Server {
+	if cfg.Assets == nil {
+		cfg.Assets = kraken.KnownAssets
+	}
+	if cfg.Pairs == nil {
+		cfg.Pairs = kraken.KnownAssetPairs
+	}
+
+	s := &Server{
+		exchange: papertrade.New(cfg.Assets, cfg.Pairs, cfg.Balances),
+		resolver: kraken.NewResolver(cfg.Assets, cfg.Pairs),
+		tier:     cfg.Tier,
+		trading:  kraken.NewTradingRateLimiter(cfg.Tier, kraken.FailOnRateLimit),
+		keys:     make(map[string]*apiKey),
+		faults:   make(map[string][]*Fault),
+		calls:    make(map[string]int),
+	}
+
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+// BaseURL returns the base URL of the API served, to be set with kraken.Client.WithBaseURL.
+func (s *Server) BaseURL() *url.URL {
+	u, _ := url.Parse(s.URL + "/" + apiVersion + "/")
+	return u
+}
+
+// NewKey creates a new API key with a random secret.
+func (s *Server) NewKey() kraken.Secrets {
+	secret := make([]byte, 64)
+	_, _ = rand.Read(secret)
+
+	key := make([]byte, 42)
+	_, _ = rand.Read(key)
+
+	secrets := kraken.Secrets{
+		Key:    base64.RawURLEncoding.EncodeToString(key),
+		Secret: base64.StdEncoding.EncodeToString(secret),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.keys[secrets.Key] = &apiKey{
+		signer:  kraken.Signer{Secret: secret},
+		limiter: kraken.NewRateLimiter(s.tier, kraken.FailOnRateLimit),
+	}
+
+	return secrets
+}
+
+// NewClient returns a kraken.Client sending its requests to the server, authenticated with the given key.
+func (s *Server) NewClient(secrets kraken.Secrets) *kraken.Client {
+	return kraken.New(s.Client()).WithBaseURL(s.BaseURL()).WithAuth(secrets)
+}
+
+// Exchange returns the simulated exchange holding the market data, balances and orders of the server,
+// e.g. to set the order books orders are matched against.
+func (s *Server) Exchange() *papertrade.Exchange {
+	return s.exchange
+}
+
+// Calls returns the number of requests received by the endpoint, e.g. "AddOrder".
+func (s *Server) Calls(endpoint string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.calls[endpoint]
+}
+
+// ServeHTTP serves the public and private endpoints of the Kraken REST API.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	visibility, endpoint, ok := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"+apiVersion+"/"), "/")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	s.mu.Lock()
+	s.calls[endpoint]++
+	s.mu.Unlock()
+
+	if s.applyFault(w, r, endpoint) {
+		return
+	}
+
+	var handlers map[string]handler
+	switch visibility {
+	case "public":
+		handlers = publicHandlers
+	case "private":
+		handlers = privateHandlers
+	}
+
+	h, ok := handlers[endpoint]
+	if !ok {
+		writeError(w, kraken.ErrUnknownMethod)
+		return
+	}
+
+	// The body is kept to verify the signature of private requests.
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := r.ParseForm(); err != nil {
+		writeError(w, kraken.ErrInvalidArguments)
+		return
+	}
+
+	if visibility == "private" {
+		if err := s.authenticate(r, endpoint, body); err != nil {
+			writeError(w, err)
+			return
+		}
+	}
+
+	v, err := h(s, r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeResult(w, v)
+}
+
+// authenticate verifies the signature and nonce of a private request, and reserves the cost
+// of the call on the call counter of its API key.
+func (s *Server) authenticate(r *http.Request, endpoint string, body []byte) error {
+	s.mu.Lock()
+	key, ok := s.keys[r.Header.Get("API-Key")]
+	s.mu.Unlock()
+
+	if !ok {
+		return kraken.ErrInvalidKey
+	}
+
+	nonce := r.PostForm.Get("nonce")
+
+	want, _ := key.signer.SignRequest(r.Context(), r.URL.Path, nonce, body)
+	if subtle.ConstantTimeCompare([]byte(want), []byte(r.Header.Get("API-Sign"))) != 1 {
+		return kraken.ErrInvalidSignature
+	}
+
+	n, err := strconv.ParseUint(nonce, 10, 64)
+	if err != nil {
+		return kraken.ErrInvalidNonce
+	}
+
+	s.mu.Lock()
+	if n <= key.nonce {
+		s.mu.Unlock()
+		return kraken.ErrInvalidNonce
+	}
+	key.nonce = n
+	s.mu.Unlock()
+
+	return key.limiter.Wait(r.Context(), endpoint)
+}
+
+type response struct {
+	Error  []string `json:"error"`
+	Result any      `json:"result,omitempty"`
+}
+
+func writeResult(w http.ResponseWriter, v any) {
+	writeResponse(w, http.StatusOK, response{Error: []string{}, Result: v})
+}
+
+// writeError writes the Kraken messages of the error. Errors that are not Kraken errors
+// are reported as internal errors, and unsupported features as disabled.
+func writeError(w http.ResponseWriter, err error) {
+	writeResponse(w, http.StatusOK, response{Error: []string{message(err)}})
+}
+
+func writeResponse(w http.ResponseWriter, status int, v response) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// message returns the Kraken message of the error.
+func message(err error) string {
+	var apiErr *kraken.APIError
+	switch {
+	case errors.As(err, &apiErr):
+		return apiErr.Error()
+	case errors.Is(err, papertrade.ErrNotSupported):
+		return kraken.ErrFeatureDisabled.Error()
+	default:
+		return kraken.ErrInternalError.Error()
+	}
+}
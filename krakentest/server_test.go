@@ -0,0 +1,235 @@
+package krakentest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jferrl/go-kraken"
+)
+
+// fixedNonce returns the same nonce for every request.
+type fixedNonce uint64
+
+func (n fixedNonce) Nonce() (uint64, error) {
+	return uint64(n), nil
+}
+
+func testServer(t *testing.T, tier kraken.Tier) *Server {
+	t.Helper()
+
+	srv := NewServer(Config{
+		Assets: kraken.Assets{
+			"XXBT": {Altname: "XBT", AssetClass: kraken.Currency, Decimals: 10},
+			"ZUSD": {Altname: "USD", AssetClass: kraken.Currency, Decimals: 4},
+		},
+		Pairs: kraken.AssetPairs{
+			"XXBTZUSD": {
+				Altname:      "XBTUSD",
+				WSName:       "XBT/USD",
+				Base:         "XXBT",
+				Quote:        "ZUSD",
+				PairDecimals: 1,
+				CostDecimals: 5,
+				LotDecimals:  8,
+				OrderMin:     "0.0001",
+				TickSize:     "0.1",
+				Status:       kraken.Online,
+			},
+		},
+		Balances: kraken.AccountBalance{"XXBT": "1", "ZUSD": "100000"},
+		Tier:     tier,
+	})
+	t.Cleanup(srv.Close)
+
+	book := kraken.NewBook(
+		[]kraken.BookLevel{{Price: "30000.0", Volume: "1"}, {Price: "30010.0", Volume: "2"}},
+		[]kraken.BookLevel{{Price: "29990.0", Volume: "1"}, {Price: "29980.0", Volume: "2"}},
+	)
+	if err := srv.Exchange().SetBook("XBTUSD", book); err != nil {
+		t.Fatalf("Exchange.SetBook() error = %v", err)
+	}
+
+	return srv
+}
+
+func TestServer_authenticate(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testServer(t, kraken.StarterTier)
+	secrets := srv.NewKey()
+
+	tests := []struct {
+		name    string
+		client  *kraken.Client
+		wantErr error
+	}{
+		{
+			name:   "valid signature",
+			client: srv.NewClient(secrets),
+		},
+		{
+			name:    "unknown key",
+			client:  srv.NewClient(kraken.Secrets{Key: "unknown", Secret: secrets.Secret}),
+			wantErr: kraken.ErrInvalidKey,
+		},
+		{
+			name:    "wrong secret",
+			client:  srv.NewClient(kraken.Secrets{Key: secrets.Key, Secret: srv.NewKey().Secret}),
+			wantErr: kraken.ErrInvalidSignature,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.client.Account.Balance(ctx)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Account.Balance() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServer_nonce(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testServer(t, kraken.StarterTier)
+	secrets := srv.NewKey()
+
+	if _, err := srv.NewClient(secrets).WithNonceSource(fixedNonce(100)).Account.Balance(ctx); err != nil {
+		t.Fatalf("Account.Balance() error = %v", err)
+	}
+
+	for _, nonce := range []fixedNonce{100, 99} {
+		_, err := srv.NewClient(secrets).WithNonceSource(nonce).Account.Balance(ctx)
+		if !errors.Is(err, kraken.ErrInvalidNonce) {
+			t.Errorf("Account.Balance() with nonce %d error = %v, want %v", nonce, err, kraken.ErrInvalidNonce)
+		}
+	}
+
+	// Nonces are tracked for each key.
+	if _, err := srv.NewClient(srv.NewKey()).WithNonceSource(fixedNonce(1)).Account.Balance(ctx); err != nil {
+		t.Errorf("Account.Balance() with another key error = %v", err)
+	}
+}
+
+func TestServer_rateLimit(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testServer(t, kraken.StarterTier)
+	c := srv.NewClient(srv.NewKey())
+
+	// The starter tier allows 15 calls before the counter decays.
+	for i := 0; i < 15; i++ {
+		if _, err := c.Account.Balance(ctx); err != nil {
+			t.Fatalf("Account.Balance() call %d error = %v", i+1, err)
+		}
+	}
+
+	if _, err := c.Account.Balance(ctx); !errors.Is(err, kraken.ErrRateLimitExceeded) {
+		t.Errorf("Account.Balance() error = %v, want %v", err, kraken.ErrRateLimitExceeded)
+	}
+
+	// Public endpoints are not counted.
+	if _, err := c.Market.Time(ctx); err != nil {
+		t.Errorf("MarketData.Time() error = %v", err)
+	}
+}
+
+func TestServer_orders(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testServer(t, kraken.StarterTier)
+	c := srv.NewClient(srv.NewKey())
+
+	limit, err := c.Trading.AddOrder(ctx, kraken.AddOrderOpts{
+		Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "29000.0", Volume: "1",
+		OrderFlags: kraken.OrderFlags{kraken.OrderFlagPost}, UserRef: "42",
+	})
+	if err != nil {
+		t.Fatalf("Trading.AddOrder() error = %v", err)
+	}
+
+	if _, err := c.Trading.AddOrder(ctx, kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Market, Volume: "0.5"}); err != nil {
+		t.Fatalf("Trading.AddOrder() error = %v", err)
+	}
+
+	open, err := c.Trading.OpenOrders(ctx, kraken.OpenOrdersOpts{})
+	if err != nil {
+		t.Fatalf("Trading.OpenOrders() error = %v", err)
+	}
+
+	txid := limit.Transaction[0]
+	if o, ok := open.Open[txid]; len(open.Open) != 1 || !ok || o.UserRef != 42 || o.OrderFlags != "post" || o.Description.PrimaryPrice != "29000.0" {
+		t.Errorf("Trading.OpenOrders() = %+v, want the limit order", open.Open)
+	}
+
+	balance, err := c.Account.ExtendedBalance(ctx)
+	if err != nil {
+		t.Fatalf("Account.ExtendedBalance() error = %v", err)
+	}
+	if b := balance["ZUSD"]; !b.Balance.Equal("85000") || !b.HoldTrade.Equal("29000") {
+		t.Errorf("Account.ExtendedBalance() ZUSD = %+v, want 85000 with 29000 held", b)
+	}
+	if b := balance["XXBT"]; !b.Balance.Equal("1.5") {
+		t.Errorf("Account.ExtendedBalance() XXBT = %+v, want 1.5", b)
+	}
+
+	cancelation, err := c.Trading.CancelOrder(ctx, kraken.CancelOrderOpts{TransactionID: string(txid)})
+	if err != nil {
+		t.Fatalf("Trading.CancelOrder() error = %v", err)
+	}
+	if cancelation.Count != 1 {
+		t.Errorf("Trading.CancelOrder() = %v, want %v", cancelation.Count, 1)
+	}
+
+	if _, err := c.Trading.CancelOrder(ctx, kraken.CancelOrderOpts{TransactionID: string(txid)}); !errors.Is(err, kraken.ErrOrderNotFound) {
+		t.Errorf("Trading.CancelOrder() error = %v, want %v", err, kraken.ErrOrderNotFound)
+	}
+
+	open, err = c.Trading.OpenOrders(ctx, kraken.OpenOrdersOpts{})
+	if err != nil {
+		t.Fatalf("Trading.OpenOrders() error = %v", err)
+	}
+	if len(open.Open) != 0 {
+		t.Errorf("Trading.OpenOrders() = %+v, want none", open.Open)
+	}
+
+	if _, err := c.Trading.AddOrder(ctx, kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.Market, Volume: "5"}); !errors.Is(err, kraken.ErrInsufficientFunds) {
+		t.Errorf("Trading.AddOrder() error = %v, want %v", err, kraken.ErrInsufficientFunds)
+	}
+}
+
+func TestServer_marketData(t *testing.T) {
+	ctx := context.Background()
+
+	srv := testServer(t, kraken.StarterTier)
+	c := kraken.New(srv.Client()).WithBaseURL(srv.BaseURL())
+
+	ob, err := c.Market.OrderBook(ctx, kraken.OrderBookOpts{Pair: "XBTUSD", Count: 1})
+	if err != nil {
+		t.Fatalf("MarketData.OrderBook() error = %v", err)
+	}
+	if len(ob.Asks) != 1 || len(ob.Bids) != 1 || ob.Asks[0][0] != "30000.0" {
+		t.Errorf("MarketData.OrderBook() = %+v, want the best levels", ob)
+	}
+
+	pairs, err := c.Market.TradableAssetPairs(ctx, kraken.TradableAssetPairsOpts{Pairs: []kraken.AssetPair{"XBTUSD"}})
+	if err != nil {
+		t.Fatalf("MarketData.TradableAssetPairs() error = %v", err)
+	}
+	if _, ok := pairs["XXBTZUSD"]; !ok {
+		t.Errorf("MarketData.TradableAssetPairs() = %v, want XXBTZUSD", pairs)
+	}
+
+	assets, err := c.Market.Assets(ctx, kraken.AssetsOpts{Assets: []kraken.Asset{"XBT", "USD"}})
+	if err != nil {
+		t.Fatalf("MarketData.Assets() error = %v", err)
+	}
+	if len(assets) != 2 {
+		t.Errorf("MarketData.Assets() = %v, want XXBT and ZUSD", assets)
+	}
+
+	if _, err := c.Market.OHCLData(ctx, kraken.OHCLDataOpts{Pair: "XBTUSD"}); !errors.Is(err, kraken.ErrUnknownMethod) {
+		t.Errorf("MarketData.OHCLData() error = %v, want %v", err, kraken.ErrUnknownMethod)
+	}
+}
@@ -0,0 +1,164 @@
+package kraken
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"sort"
+)
+
+// redacted replaces the credentials and one-time passwords in logs.
+const redacted = "REDACTED"
+
+// redactedHeaders are the request headers holding credentials.
+var redactedHeaders = []string{"API-Key", "API-Sign", "Authorization", "Cookie"}
+
+// LogOptions configures the logging of the calls, see WithLogger.
+type LogOptions struct {
+	// Level of the successful calls. Failed calls are logged as warnings, or
+	// at Level if higher. Defaults to slog.LevelDebug.
+	Level slog.Leveler
+	// Headers logs the headers of the requests sent.
+	Headers bool
+	// PrivateBodies logs the body of private requests. The parameters of public
+	// requests are always logged, as they are part of the URL.
+	PrivateBodies bool
+}
+
+// WithLogger logs every attempt of a call with its endpoint, method, nonce, status code,
+// latency, Kraken errors and the state of the call counter. API keys, signatures and
+// one-time passwords are always redacted.
+// The logger is added as a middleware, see WithMiddleware.
+func (c *Client) WithLogger(l *slog.Logger, opts LogOptions) *Client {
+	return c.WithMiddleware(AfterCall(func(call *Call, err error) {
+		logCall(l, opts, call, err)
+	}))
+}
+
+func logCall(l *slog.Logger, opts LogOptions, call *Call, err error) {
+	req := call.Request
+	if call.sent != nil {
+		req = call.sent
+	}
+
+	level := slog.LevelDebug
+	if opts.Level != nil {
+		level = opts.Level.Level()
+	}
+	if err != nil && level < slog.LevelWarn {
+		level = slog.LevelWarn
+	}
+
+	ctx := req.Context()
+	if !l.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("endpoint", call.Endpoint),
+		slog.String("method", req.Method),
+		slog.Bool("private", call.Private),
+		slog.Int("attempt", call.Attempt),
+	}
+
+	if call.body != nil {
+		if nonce := call.body.nonce(); nonce != "" {
+			attrs = append(attrs, slog.String("nonce", nonce))
+		}
+	}
+
+	if call.StatusCode != 0 {
+		attrs = append(attrs, slog.Int("status", call.StatusCode))
+	}
+	attrs = append(attrs, slog.Duration("latency", call.Latency))
+
+	// Params never hold the nonce, logged above, nor the one-time password.
+	if !call.Private || opts.PrivateBodies {
+		attrs = append(attrs, slog.Any("params", valuesLogValue(call.Params())))
+	}
+	if opts.Headers {
+		attrs = append(attrs, slog.Any("headers", valuesLogValue(RedactHeader(req.Header))))
+	}
+
+	if l := call.rateLimiter; l != nil {
+		attrs = append(attrs, slog.Group("rate_limit",
+			slog.Float64("counter", l.Counter()),
+			slog.Float64("limit", l.Limit()),
+		))
+	}
+
+	if len(call.Warnings) > 0 {
+		attrs = append(attrs, slog.Any("kraken_warnings", messages(call.Warnings)))
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+
+		var e *Error
+		if errors.As(err, &e) {
+			attrs = append(attrs, slog.Any("kraken_errors", messages(e.Errors)))
+		}
+	}
+
+	msg := "kraken call"
+	if err != nil {
+		msg = "kraken call failed"
+	}
+
+	l.LogAttrs(ctx, level, msg, attrs...)
+}
+
+// messages returns the Kraken messages of the errors.
+func messages(errs []*APIError) []string {
+	s := make([]string, len(errs))
+	for i, e := range errs {
+		s[i] = e.Error()
+	}
+	return s
+}
+
+// RedactHeader returns a copy of the header with the API key, the signature and other
+// credentials replaced by "REDACTED", so that requests can be printed or logged safely.
+func RedactHeader(h http.Header) http.Header {
+	h = h.Clone()
+	for _, k := range redactedHeaders {
+		if h.Get(k) != "" {
+			h.Set(k, redacted)
+		}
+	}
+	return h
+}
+
+// valuesLogValue returns the values as a group, sorted by key.
+func valuesLogValue[M ~map[string][]string](m M) slog.Value {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	attrs := make([]slog.Attr, 0, len(keys))
+	for _, k := range keys {
+		if v := m[k]; len(v) == 1 {
+			attrs = append(attrs, slog.String(k, v[0]))
+		} else {
+			attrs = append(attrs, slog.Any(k, v))
+		}
+	}
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue redacts the API key and secret, so that they are not leaked when logged.
+func (s Secrets) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
+// LogValue redacts the secret, so that it is not leaked when logged.
+func (s Secret) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
+
+// LogValue redacts the one-time password, so that it is not leaked when logged.
+func (o Otp) LogValue() slog.Value {
+	return slog.StringValue(redacted)
+}
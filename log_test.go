@@ -0,0 +1,168 @@
+package kraken
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestClient_WithLogger(t *testing.T) {
+	secrets := Secrets{Key: "my-api-key", Secret: "c2VjcmV0"}
+
+	tests := []struct {
+		name    string
+		res     string
+		opts    LogOptions
+		call    func(c *Client) error
+		want    map[string]any
+		missing []string
+	}{
+		{
+			name: "private call without body",
+			res:  "add_order.json",
+			call: func(c *Client) error {
+				_, err := c.Trading.AddOrder(ContextWithOtp(context.Background(), "123456"), AddOrderOpts{OrderType: Market, Type: Buy, Volume: "1", Pair: "XXBTZUSD"})
+				return err
+			},
+			want: map[string]any{
+				"level":      "DEBUG",
+				"msg":        "kraken call",
+				"endpoint":   "AddOrder",
+				"method":     http.MethodPost,
+				"private":    true,
+				"attempt":    float64(1),
+				"status":     float64(http.StatusOK),
+				"rate_limit": map[string]any{"counter": float64(0), "limit": float64(15)},
+			},
+			missing: []string{"params", "headers"},
+		},
+		{
+			name: "private call with body and headers",
+			res:  "add_order.json",
+			opts: LogOptions{Level: slog.LevelInfo, Headers: true, PrivateBodies: true},
+			call: func(c *Client) error {
+				_, err := c.Trading.AddOrder(ContextWithOtp(context.Background(), "123456"), AddOrderOpts{OrderType: Market, Type: Buy, Volume: "1", Pair: "XXBTZUSD"})
+				return err
+			},
+			want: map[string]any{
+				"level":  "INFO",
+				"params": map[string]any{"ordertype": "market", "pair": "XXBTZUSD", "type": "buy", "volume": "1"},
+			},
+		},
+		{
+			name: "failed call",
+			res:  "error_response.json",
+			call: func(c *Client) error {
+				_, err := c.Account.Balance(context.Background())
+				return err
+			},
+			want: map[string]any{
+				"level":         "WARN",
+				"msg":           "kraken call failed",
+				"endpoint":      "Balance",
+				"kraken_errors": []any{"error1", "error2"},
+			},
+		},
+		{
+			name: "public call",
+			res:  "server_time.json",
+			call: func(c *Client) error {
+				_, err := c.Market.OrderBook(context.Background(), OrderBookOpts{Pair: XXBTZUSD, Count: 2})
+				return err
+			},
+			want: map[string]any{
+				"endpoint": "Depth",
+				"method":   http.MethodGet,
+				"private":  false,
+				"params":   map[string]any{"count": "2", "pair": "XXBTZUSD"},
+			},
+			missing: []string{"nonce", "rate_limit"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiMock := createFakeServer(http.StatusOK, tt.res)
+			defer apiMock.Close()
+
+			var buf bytes.Buffer
+			logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+
+			baseURL, _ := url.Parse(apiMock.URL + "/")
+
+			c := New(apiMock.Client()).
+				WithAuth(secrets).
+				WithRateLimiter(NewRateLimiter(StarterTier, FailOnRateLimit)).
+				WithLogger(logger, tt.opts)
+			c.baseURL = baseURL
+
+			_ = tt.call(c)
+
+			out := buf.String()
+			for _, leak := range []string{secrets.Key, "123456"} {
+				if strings.Contains(out, leak) {
+					t.Errorf("log = %s, leaks %q", out, leak)
+				}
+			}
+
+			var got map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+				t.Fatalf("decoding log %q: %v", out, err)
+			}
+
+			for k, want := range tt.want {
+				if !reflect.DeepEqual(got[k], want) {
+					t.Errorf("log[%q] = %v, want %v", k, got[k], want)
+				}
+			}
+			for _, k := range tt.missing {
+				if _, ok := got[k]; ok {
+					t.Errorf("log[%q] = %v, want missing", k, got[k])
+				}
+			}
+
+			if got["private"] == true {
+				if nonce, _ := got["nonce"].(string); nonce == "" {
+					t.Errorf("log[nonce] = %v, want the nonce of the request", got["nonce"])
+				}
+			}
+			if headers, ok := got["headers"].(map[string]any); tt.opts.Headers &&
+				(!ok || headers["Api-Key"] != redacted || headers["Api-Sign"] != redacted) {
+				t.Errorf("log[headers] = %v, want the credentials redacted", got["headers"])
+			}
+		})
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+
+	logger.Info("credentials",
+		"secrets", Secrets{Key: "my-api-key", Secret: "c2VjcmV0"},
+		"secret", Secret("secret"),
+		"otp", Otp("123456"),
+	)
+
+	want := "secrets=REDACTED secret=REDACTED otp=REDACTED"
+	if got := buf.String(); !strings.Contains(got, want) {
+		t.Errorf("log = %q, want %q", got, want)
+	}
+}
+
+func TestRedactHeader(t *testing.T) {
+	h := http.Header{"Api-Key": {"my-api-key"}, "Api-Sign": {"signature"}, "User-Agent": {userAgent}}
+
+	want := http.Header{"Api-Key": {redacted}, "Api-Sign": {redacted}, "User-Agent": {userAgent}}
+	if got := RedactHeader(h); !reflect.DeepEqual(got, want) {
+		t.Errorf("RedactHeader() = %v, want %v", got, want)
+	}
+	if h.Get("Api-Key") != "my-api-key" {
+		t.Errorf("RedactHeader() modified the header")
+	}
+}
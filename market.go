@@ -47,7 +47,7 @@ func (m *MarketData) SystemStatus(ctx context.Context) (*SystemStatus, error) {
 
 // AssetsOpts represents the parameters to get information about the assets available for trading on Kraken.
 type AssetsOpts struct {
-	Assets []Asset    `url:"assets,omitempty"`
+	Assets []Asset    `url:"asset,comma,omitempty"`
 	Class  AssetClass `url:"aclass,omitempty"`
 }
 
@@ -85,7 +85,7 @@ func (m *MarketData) Assets(ctx context.Context, opts AssetsOpts) (Assets, error
 
 // TradableAssetPairsOpts represents the parameters to get information about the asset pairs available for trading on Kraken.
 type TradableAssetPairsOpts struct {
-	Pairs []AssetPair `url:"pair,omitempty"`
+	Pairs []AssetPair `url:"pair,comma,omitempty"`
 	Info  PairInfo    `url:"info,omitempty"`
 }
 
@@ -123,7 +123,7 @@ func (m *MarketData) TradableAssetPairs(ctx context.Context, opts TradableAssetP
 
 // TickerInformationOpts represents the parameters to get ticker information about the asset pairs available for trading on Kraken.
 type TickerInformationOpts struct {
-	Pairs []AssetPair `url:"pair,omitempty"`
+	Pairs []AssetPair `url:"pair,comma,omitempty"`
 }
 
 // IsZero returns true if the TradableAssetPairsOpts is empty.
@@ -196,8 +196,15 @@ func (m *MarketData) OHCLData(ctx context.Context, opts OHCLDataOpts) (*OHCL, er
 		last = int64(l)
 	}
 
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		if k != "last" {
+			keys = append(keys, k)
+		}
+	}
+
 	var ticks OHCLTickers
-	if p, ok := v[string(opts.Pair)].([]any); ok {
+	if p, ok := v[m.client.responsePair(opts.Pair, keys)].([]any); ok {
 		for _, t := range p {
 			if pv, ok := t.([]any); ok {
 				ticks = append(ticks, pv)
@@ -241,12 +248,17 @@ func (m *MarketData) OrderBook(ctx context.Context, opts OrderBookOpts) (*OrderB
 		return nil, err
 	}
 
-	var v map[AssetPair]OrderBook
+	var v map[string]OrderBook
 	if err := m.client.do(req, &v); err != nil {
 		return nil, err
 	}
 
-	pair := v[opts.Pair]
+	keys := make([]string, 0, len(v))
+	for k := range v {
+		keys = append(keys, k)
+	}
+
+	pair := v[m.client.responsePair(opts.Pair, keys)]
 
 	return &pair, nil
 }
@@ -440,14 +440,14 @@ func TestMarketData_TickerInformation(t *testing.T) {
 			},
 			pair: XXBTZUSD,
 			want: AssetTickerInfo{
-				Ask:                        []string{"30300.10000", "1", "1.000"},
-				Bid:                        []string{"30300.00000", "1", "1.000"},
-				Last:                       []string{"30303.20000", "0.00067643"},
-				Volume:                     []string{"4083.67001100", "4412.73601799"},
-				VolumeWeightedAveragePrice: []string{"30706.77771", "30689.13205"},
+				Ask:                        []Decimal{"30300.10000", "1", "1.000"},
+				Bid:                        []Decimal{"30300.00000", "1", "1.000"},
+				Last:                       []Decimal{"30303.20000", "0.00067643"},
+				Volume:                     []Decimal{"4083.67001100", "4412.73601799"},
+				VolumeWeightedAveragePrice: []Decimal{"30706.77771", "30689.13205"},
 				NumberOfTrades:             []int{34619, 38907},
-				Low:                        []string{"29868.30000", "29868.30000"},
-				High:                       []string{"31631.00000", "31631.00000"},
+				Low:                        []Decimal{"29868.30000", "29868.30000"},
+				High:                       []Decimal{"31631.00000", "31631.00000"},
 				OpeningPrice:               "30502.80000",
 			},
 		},
@@ -582,3 +582,65 @@ func TestMarketData_OrderBook(t *testing.T) {
 		})
 	}
 }
+
+func TestMarketData_listQuery(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name string
+		res  string
+		call func(c *Client) error
+		want string
+	}{
+		{
+			name: "assets",
+			res:  "asset_info.json",
+			call: func(c *Client) error {
+				_, err := c.Market.Assets(ctx, AssetsOpts{Assets: []Asset{XXBT, ZUSD}})
+				return err
+			},
+			want: "asset=XXBT%2CZUSD",
+		},
+		{
+			name: "asset pairs",
+			res:  "asset_pairs.json",
+			call: func(c *Client) error {
+				_, err := c.Market.TradableAssetPairs(ctx, TradableAssetPairsOpts{Pairs: []AssetPair{XXBTZUSD, XETHZUSD}})
+				return err
+			},
+			want: "pair=XXBTZUSD%2CXETHZUSD",
+		},
+		{
+			name: "tickers",
+			res:  "tickers.json",
+			call: func(c *Client) error {
+				_, err := c.Market.TickerInformation(ctx, TickerInformationOpts{Pairs: []AssetPair{XXBTZUSD, XETHZUSD}})
+				return err
+			},
+			want: "pair=XXBTZUSD%2CXETHZUSD",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+
+			apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r.URL.RawQuery
+				http.ServeFile(w, r, "testdata/"+tt.res)
+			}))
+			defer apiMock.Close()
+
+			baseURL, _ := url.Parse(apiMock.URL + "/")
+
+			c := New(apiMock.Client())
+			c.baseURL = baseURL
+
+			if err := tt.call(c); err != nil {
+				t.Fatalf("call error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("query = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package kraken
+
+import (
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// Call represents an attempt to call a Kraken endpoint. It is passed through the middlewares
+// of the client before the request is sent, and holds the outcome of the attempt afterwards.
+type Call struct {
+	// Endpoint is the logical name of the endpoint, e.g. "AddOrder", "Balance" or "Earn/Strategies".
+	Endpoint string
+	// Private reports whether the endpoint requires authentication.
+	Private bool
+	// Attempt is the number of the attempt, starting at 1, incremented on each retry.
+	Attempt int
+	// Request is the request to send. Private requests are signed after the middlewares run,
+	// so headers added by the middlewares are sent but the body must not be altered.
+	Request *http.Request
+
+	// Result is the value the result of the response was decoded into, set if the call succeeded.
+	Result any
+	// StatusCode is the HTTP status code of the response, zero if no response was received.
+	StatusCode int
+	// Latency is the time spent sending the request and decoding its response.
+	Latency time.Duration
+	// Warnings are the warnings returned by Kraken, e.g. "WGeneral:Deprecated",
+	// which do not make the call fail.
+	Warnings []*APIError
+
+	body        reqBody
+	sent        *http.Request // Request actually sent, signed for private calls.
+	rateLimiter *RateLimiter  // Limiter of the API key of private calls, if any.
+}
+
+// Params returns the parameters of the call, without the nonce and the one-time password.
+func (c *Call) Params() url.Values {
+	var params url.Values
+	if c.body != nil {
+		params = c.body.params()
+	} else {
+		params = c.Request.URL.Query()
+	}
+
+	params.Del(nonceKey)
+	params.Del("otp")
+
+	return params
+}
+
+// CallHandler performs a call to a Kraken endpoint.
+type CallHandler func(call *Call) error
+
+// Middleware wraps the handler performing calls, e.g. to log, audit or measure them,
+// or to add custom headers. Middlewares run on each attempt of a call.
+type Middleware func(next CallHandler) CallHandler
+
+// BeforeCall returns a middleware that calls f before each attempt.
+// The call is aborted with the error returned by f, if any.
+func BeforeCall(f func(call *Call) error) Middleware {
+	return func(next CallHandler) CallHandler {
+		return func(call *Call) error {
+			if err := f(call); err != nil {
+				return err
+			}
+			return next(call)
+		}
+	}
+}
+
+// AfterCall returns a middleware that calls f after each attempt, with its error.
+// Kraken errors are decoded, see Error and APIError.
+func AfterCall(f func(call *Call, err error)) Middleware {
+	return func(next CallHandler) CallHandler {
+		return func(call *Call) error {
+			err := next(call)
+			f(call, err)
+			return err
+		}
+	}
+}
+
+// endpointMiddleware is a middleware applied to some endpoints, or every endpoint if none.
+type endpointMiddleware struct {
+	middleware Middleware
+	endpoints  map[string]bool
+}
+
+// WithMiddleware adds a middleware to the calls to the given endpoints, e.g. "AddOrder"
+// or "Balance", or to every call if no endpoint is given. Middlewares run in the order
+// they were added, the first one being the outermost.
+func (c *Client) WithMiddleware(m Middleware, endpoints ...string) *Client {
+	em := endpointMiddleware{middleware: m}
+
+	if len(endpoints) > 0 {
+		em.endpoints = make(map[string]bool, len(endpoints))
+		for _, e := range endpoints {
+			em.endpoints[e] = true
+		}
+	}
+
+	c.middlewares = append(c.middlewares, em)
+
+	return c
+}
+
+// chain returns the handler of the calls to the endpoint, wrapped by its middlewares.
+func (c *Client) chain(endpoint string, h CallHandler) CallHandler {
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		m := c.middlewares[i]
+		if m.endpoints == nil || m.endpoints[endpoint] {
+			h = m.middleware(h)
+		}
+	}
+	return h
+}
@@ -0,0 +1,158 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClient_WithMiddleware(t *testing.T) {
+	ctx := ContextWithOtp(context.Background(), "123456")
+
+	var auditHeader string
+	apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auditHeader = r.Header.Get("X-Audit")
+
+		res := "add_order.json"
+		if r.URL.Path == "/private/Balance" {
+			res = "error_response.json"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		http.ServeFile(w, r, filepath.Join("testdata", res))
+	}))
+	defer apiMock.Close()
+
+	var (
+		order []string
+		calls []Call
+		errs  []error
+	)
+
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	c := New(apiMock.Client()).
+		WithAuth(Secrets{Key: "key", Secret: "c2VjcmV0"}).
+		WithMiddleware(func(next CallHandler) CallHandler {
+			return func(call *Call) error {
+				order = append(order, "outer")
+				return next(call)
+			}
+		}).
+		WithMiddleware(BeforeCall(func(call *Call) error {
+			order = append(order, "audit")
+			call.Request.Header.Set("X-Audit", "trading")
+			return nil
+		}), "AddOrder").
+		WithMiddleware(AfterCall(func(call *Call, err error) {
+			calls = append(calls, *call)
+			errs = append(errs, err)
+		}))
+	c.baseURL = baseURL
+
+	if _, err := c.Trading.AddOrder(ctx, AddOrderOpts{OrderType: Limit, Type: Buy, Volume: "1", Pair: "XXBTZUSD", Price: "1000"}); err != nil {
+		t.Fatalf("Trading.AddOrder() error = %v", err)
+	}
+
+	if auditHeader != "trading" {
+		t.Errorf("X-Audit header = %q, want %q", auditHeader, "trading")
+	}
+
+	if _, err := c.Account.Balance(ctx); err == nil {
+		t.Fatal("Account.Balance() error = nil, want an error")
+	}
+
+	if want := []string{"outer", "audit", "outer"}; !reflect.DeepEqual(order, want) {
+		t.Errorf("middlewares ran in order %v, want %v", order, want)
+	}
+
+	if len(calls) != 2 {
+		t.Fatalf("calls = %d, want %d", len(calls), 2)
+	}
+
+	addOrder := calls[0]
+	if addOrder.Endpoint != "AddOrder" || !addOrder.Private || addOrder.Attempt != 1 || addOrder.StatusCode != http.StatusOK || addOrder.Latency <= 0 {
+		t.Errorf("AddOrder call = %+v", addOrder)
+	}
+	if v, ok := addOrder.Result.(*OrderCreation); !ok || len(v.Transaction) != 1 || errs[0] != nil {
+		t.Errorf("AddOrder result = %v, error = %v, want the order created", addOrder.Result, errs[0])
+	}
+
+	wantParams := url.Values{"ordertype": {"limit"}, "type": {"buy"}, "volume": {"1"}, "pair": {"XXBTZUSD"}, "price": {"1000"}}
+	if got := addOrder.Params(); !reflect.DeepEqual(got, wantParams) {
+		t.Errorf("Call.Params() = %v, want %v", got, wantParams)
+	}
+
+	var apiErr *Error
+	if calls[1].Endpoint != "Balance" || calls[1].Result != nil || !errors.As(errs[1], &apiErr) {
+		t.Errorf("Balance call = %+v, error = %v, want a decoded Kraken error", calls[1], errs[1])
+	}
+}
+
+func TestClient_WithMiddleware_abort(t *testing.T) {
+	apiMock := createFakeServer(http.StatusOK, "server_time.json")
+	defer apiMock.Close()
+
+	errAborted := errors.New("aborted")
+
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	c := New(apiMock.Client()).
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}).
+		WithMiddleware(BeforeCall(func(call *Call) error {
+			if call.Attempt == 1 {
+				return &HTTPError{StatusCode: http.StatusBadGateway}
+			}
+			if call.Attempt == 2 {
+				return errAborted
+			}
+			return nil
+		}), "Time")
+	c.baseURL = baseURL
+
+	if _, err := c.Market.Time(context.Background()); !errors.Is(err, errAborted) {
+		t.Errorf("MarketData.Time() error = %v, want %v", err, errAborted)
+	}
+
+	// Other endpoints are not affected.
+	if _, err := c.Market.SystemStatus(context.Background()); err != nil {
+		t.Errorf("MarketData.SystemStatus() error = %v", err)
+	}
+}
+
+func TestCall_Params(t *testing.T) {
+	tests := []struct {
+		name string
+		call *Call
+		want url.Values
+	}{
+		{
+			name: "public",
+			call: &Call{Request: httptest.NewRequest(http.MethodGet, "/public/Depth?pair=XBTUSD&count=10", nil)},
+			want: url.Values{"pair": {"XBTUSD"}, "count": {"10"}},
+		},
+		{
+			name: "form body",
+			call: &Call{body: newFormURLEncodedBody(url.Values{"txid": {"OID"}, "nonce": {"1"}, "otp": {"123456"}})},
+			want: url.Values{"txid": {"OID"}},
+		},
+		{
+			name: "json body",
+			call: &Call{body: jsonBody{jsonMessage{"asset": "DOT", "limit": 10, "nonce": "1"}}},
+			want: url.Values{"asset": {"DOT"}, "limit": {"10"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.call.Params(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Call.Params() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
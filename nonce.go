@@ -0,0 +1,140 @@
+package kraken
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// NonceSource generates the nonces of private requests.
+// Nonces must be strictly increasing for each API key.
+type NonceSource interface {
+	Nonce() (uint64, error)
+}
+
+// MonotonicNonceSource generates strictly increasing nonces based on the current time
+// in nanoseconds. It is safe for concurrent use.
+type MonotonicNonceSource struct {
+	last atomic.Uint64
+
+	now func() time.Time
+}
+
+// NewMonotonicNonceSource returns a new MonotonicNonceSource.
+func NewMonotonicNonceSource() *MonotonicNonceSource {
+	return &MonotonicNonceSource{now: time.Now}
+}
+
+// Nonce returns the current time in nanoseconds, or the last nonce plus one
+// if the clock did not move forward since the last call.
+func (n *MonotonicNonceSource) Nonce() (uint64, error) {
+	for {
+		last := n.last.Load()
+		next := max(uint64(n.now().UnixNano()), last+1)
+
+		if n.last.CompareAndSwap(last, next) {
+			return next, nil
+		}
+	}
+}
+
+// FileNonceSource generates strictly increasing nonces and persists the last one to a file,
+// so that nonces keep increasing across restarts even if the clock moves backwards.
+// It is safe for concurrent use within a process.
+type FileNonceSource struct {
+	mu   sync.Mutex
+	path string
+	last uint64
+
+	now func() time.Time
+}
+
+// NewFileNonceSource returns a new FileNonceSource that persists nonces to the given path.
+// The file is created on the first nonce if it does not exist.
+func NewFileNonceSource(path string) (*FileNonceSource, error) {
+	n := &FileNonceSource{path: path, now: time.Now}
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return n, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	last, err := strconv.ParseUint(strings.TrimSpace(string(b)), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid nonce file %s: %w", path, err)
+	}
+	n.last = last
+
+	return n, nil
+}
+
+// Nonce returns the next nonce once it has been persisted.
+func (n *FileNonceSource) Nonce() (uint64, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	next := max(uint64(n.now().UnixNano()), n.last+1)
+
+	if err := n.persist(next); err != nil {
+		return 0, err
+	}
+	n.last = next
+
+	return next, nil
+}
+
+// persist atomically replaces the nonce file. The file and its directory are synced,
+// so that the nonce survives a crash once it is returned.
+func (n *FileNonceSource) persist(nonce uint64) error {
+	dir := filepath.Dir(n.path)
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(n.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(strconv.FormatUint(nonce, 10)); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), n.path); err != nil {
+		return err
+	}
+
+	return syncDir(dir)
+}
+
+// syncDir flushes the entries of the directory, e.g. a renamed file, to disk.
+// Directories cannot be synced on Windows, so they are skipped there.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+
+	return d.Sync()
+}
@@ -0,0 +1,157 @@
+package kraken
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestMonotonicNonceSource_Nonce(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+	n := NewMonotonicNonceSource()
+	n.now = clock.now
+
+	first, _ := n.Nonce()
+	if want := uint64(clock.t.UnixNano()); first != want {
+		t.Errorf("MonotonicNonceSource.Nonce() = %v, want %v", first, want)
+	}
+
+	second, _ := n.Nonce()
+	if second != first+1 {
+		t.Errorf("MonotonicNonceSource.Nonce() = %v, want %v", second, first+1)
+	}
+
+	clock.advance(-time.Hour)
+
+	third, _ := n.Nonce()
+	if third != second+1 {
+		t.Errorf("MonotonicNonceSource.Nonce() = %v, want %v", third, second+1)
+	}
+}
+
+func TestMonotonicNonceSource_Nonce_concurrent(t *testing.T) {
+	n := NewMonotonicNonceSource()
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		nonces = map[uint64]bool{}
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				nonce, _ := n.Nonce()
+
+				mu.Lock()
+				nonces[nonce] = true
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(nonces) != 1000 {
+		t.Errorf("MonotonicNonceSource.Nonce() generated %v unique nonces, want %v", len(nonces), 1000)
+	}
+}
+
+func TestFileNonceSource_Nonce(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nonce")
+
+	clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+	n, err := NewFileNonceSource(path)
+	if err != nil {
+		t.Fatalf("NewFileNonceSource() error = %v", err)
+	}
+	n.now = clock.now
+
+	first, err := n.Nonce()
+	if err != nil {
+		t.Fatalf("FileNonceSource.Nonce() error = %v", err)
+	}
+
+	// Restart with a clock that moved backwards.
+	clock.advance(-time.Hour)
+
+	n, err = NewFileNonceSource(path)
+	if err != nil {
+		t.Fatalf("NewFileNonceSource() error = %v", err)
+	}
+	n.now = clock.now
+
+	second, err := n.Nonce()
+	if err != nil {
+		t.Fatalf("FileNonceSource.Nonce() error = %v", err)
+	}
+	if second != first+1 {
+		t.Errorf("FileNonceSource.Nonce() = %v, want %v", second, first+1)
+	}
+
+	b, _ := os.ReadFile(path)
+	if string(b) != strconv.FormatUint(second, 10) {
+		t.Errorf("nonce file = %s, want %v", b, second)
+	}
+}
+
+func TestNewFileNonceSource_invalidFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nonce")
+	_ = os.WriteFile(path, []byte("invalid"), 0o600)
+
+	if _, err := NewFileNonceSource(path); err == nil {
+		t.Errorf("NewFileNonceSource() error = nil, want error")
+	}
+}
+
+func TestClient_WithSerializedRequests(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		nonces []uint64
+	)
+
+	apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		nonce, _ := strconv.ParseUint(r.PostForm.Get("nonce"), 10, 64)
+
+		mu.Lock()
+		nonces = append(nonces, nonce)
+		mu.Unlock()
+
+		http.ServeFile(w, r, "testdata/account_balance.json")
+	}))
+	defer apiMock.Close()
+
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	c := New(apiMock.Client()).WithSerializedRequests()
+	c.baseURL = baseURL
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.Account.Balance(context.Background()); err != nil {
+				t.Errorf("Account.Balance() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i := 1; i < len(nonces); i++ {
+		if nonces[i] <= nonces[i-1] {
+			t.Fatalf("nonce %v received after %v", nonces[i], nonces[i-1])
+		}
+	}
+}
@@ -0,0 +1,458 @@
+package kraken
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OrderPrice is the price of an order. Besides absolute prices, Kraken accepts prices
+// relative to the last traded price: "+5" and "-5" add or subtract an amount, "#5" adds
+// or subtracts it depending on the direction of the order, and a "%" suffix, e.g. "+1.5%",
+// makes the offset a percentage.
+type OrderPrice string
+
+// AbsolutePrice returns an absolute order price.
+func AbsolutePrice(price Decimal) OrderPrice {
+	return OrderPrice(price)
+}
+
+// RelativePrice returns a price offset from the last traded price, e.g. "+5" or "-5".
+func RelativePrice(offset Decimal) OrderPrice {
+	return relativePrice(offset, "")
+}
+
+// RelativePercent returns a price offset from the last traded price by a percentage, e.g. "+1.5%".
+func RelativePercent(percent Decimal) OrderPrice {
+	return relativePrice(percent, "%")
+}
+
+// TrailingOffset returns the offset of a trailing stop from the best price, e.g. "+5".
+func TrailingOffset(offset Decimal) OrderPrice {
+	return OrderPrice("+" + string(offset.Abs()))
+}
+
+// TrailingPercent returns the offset of a trailing stop from the best price by a percentage, e.g. "+1.5%".
+func TrailingPercent(percent Decimal) OrderPrice {
+	return OrderPrice("+" + string(percent.Abs()) + "%")
+}
+
+func relativePrice(offset Decimal, suffix string) OrderPrice {
+	if offset.Sign() < 0 {
+		return OrderPrice(string(offset) + suffix)
+	}
+	return OrderPrice("+" + string(offset) + suffix)
+}
+
+// IsRelative returns true if the price is relative to the last traded price.
+func (p OrderPrice) IsRelative() bool {
+	s := string(p)
+	return strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-") || strings.HasPrefix(s, "#") || strings.HasSuffix(s, "%")
+}
+
+// Decimal returns the absolute price. It returns false if the price is relative.
+func (p OrderPrice) Decimal() (Decimal, bool) {
+	if p.IsRelative() {
+		return "", false
+	}
+	return Decimal(p), true
+}
+
+// String returns the price as sent to Kraken.
+func (p OrderPrice) String() string {
+	return string(p)
+}
+
+// OrderFlags is a set of order flags, sent as a comma delimited list.
+type OrderFlags []OrderFlag
+
+// Has returns true if the set contains the flag.
+func (f OrderFlags) Has(flag OrderFlag) bool {
+	for _, v := range f {
+		if v == flag {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the flags as sent to Kraken, e.g. "post,fcib".
+func (f OrderFlags) String() string {
+	flags := make([]string, 0, len(f))
+	for i, v := range f {
+		if !f[:i].Has(v) {
+			flags = append(flags, string(v))
+		}
+	}
+	return strings.Join(flags, ",")
+}
+
+// EncodeValues implements query.Encoder. It fails if the set contains an unknown flag.
+func (f OrderFlags) EncodeValues(key string, v *url.Values) error {
+	if err := f.check(); err != nil {
+		return err
+	}
+	v.Set(key, f.String())
+	return nil
+}
+
+func (f OrderFlags) check() error {
+	for _, v := range f {
+		switch v {
+		case OrderFlagPost, OrderFlagFeeInBase, OrderFlagFeeInQuote, OrderFlagNoMarketPriceProtection, OrderFlagVolumeInQuote:
+		default:
+			return fmt.Errorf("unknown order flag %q", v)
+		}
+	}
+	return nil
+}
+
+// OrderTime is the start or expiration time of an order, either a point in time or
+// a duration relative to the time Kraken receives the order. The zero value is not sent.
+type OrderTime struct {
+	at       time.Time
+	after    time.Duration
+	relative bool
+}
+
+// OrderTimeAt returns an order time at t, sent as a unix timestamp.
+func OrderTimeAt(t time.Time) OrderTime {
+	return OrderTime{at: t}
+}
+
+// OrderTimeIn returns an order time d after Kraken receives the order, sent as
+// "+<n>" seconds. Durations are rounded up to the second and must be positive.
+func OrderTimeIn(d time.Duration) OrderTime {
+	return OrderTime{after: d, relative: true}
+}
+
+// IsZero returns true if the time is not set.
+func (t OrderTime) IsZero() bool {
+	return t.at.IsZero() && !t.relative
+}
+
+// check checks that a relative time is positive.
+func (t OrderTime) check(field string) error {
+	if t.relative && t.after <= 0 {
+		return invalid(RuleOrderTime, field, fmt.Sprintf("%v is not a positive duration", t.after), ErrInvalidArguments)
+	}
+	return nil
+}
+
+// String returns the time as sent to Kraken.
+func (t OrderTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	if !t.at.IsZero() {
+		return strconv.FormatInt(t.at.Unix(), 10)
+	}
+	return "+" + strconv.FormatInt(seconds(t.after), 10)
+}
+
+// EncodeValues implements query.Encoder.
+func (t OrderTime) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, t.String())
+	return nil
+}
+
+// MaxOrderDeadline is how far in the future the deadline of an order can be.
+const MaxOrderDeadline = 60 * time.Second
+
+// deadlineLayout is the RFC3339 layout of deadlines, with milliseconds.
+const deadlineLayout = "2006-01-02T15:04:05.000Z07:00"
+
+// OrderDeadline is the time after which the matching engine rejects an order,
+// sent as a RFC3339 timestamp with milliseconds. It must be at most MaxOrderDeadline in the future.
+// The zero value is not sent.
+type OrderDeadline struct {
+	time.Time
+}
+
+// DeadlineIn returns the deadline d from now.
+func DeadlineIn(d time.Duration) OrderDeadline {
+	return OrderDeadline{time.Now().Add(d)}
+}
+
+// String returns the deadline as sent to Kraken.
+func (d OrderDeadline) String() string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.UTC().Format(deadlineLayout)
+}
+
+// EncodeValues implements query.Encoder.
+func (d OrderDeadline) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, d.String())
+	return nil
+}
+
+// OrderBuilder builds the AddOrderOpts of an order type, only exposing the
+// parameters that are valid for it. Use one of the New*Order functions to create one.
+type OrderBuilder struct {
+	opts AddOrderOpts
+}
+
+func newOrder(pair AssetPair, orderType OrderType, direction OrderDirection, volume Decimal) *OrderBuilder {
+	return &OrderBuilder{opts: AddOrderOpts{
+		Pair:      string(pair),
+		OrderType: orderType,
+		Type:      direction,
+		Volume:    volume,
+	}}
+}
+
+// NewMarketOrder returns a builder of an order filled at the best available price.
+func NewMarketOrder(pair AssetPair, direction OrderDirection, volume Decimal) *OrderBuilder {
+	return newOrder(pair, Market, direction, volume)
+}
+
+// NewLimitOrder returns a builder of an order filled at the limit price or better.
+func NewLimitOrder(pair AssetPair, direction OrderDirection, volume Decimal, price OrderPrice) *OrderBuilder {
+	b := newOrder(pair, Limit, direction, volume)
+	b.opts.Price = price
+	return b
+}
+
+// NewIcebergOrder returns a builder of a limit order that only shows the display volume in the order book.
+func NewIcebergOrder(pair AssetPair, direction OrderDirection, volume, displayVolume Decimal, price OrderPrice) *OrderBuilder {
+	b := NewLimitOrder(pair, direction, volume, price)
+	b.opts.DisplayVol = displayVolume
+	return b
+}
+
+// NewStopLossOrder returns a builder of a market order triggered when the price moves against the position.
+func NewStopLossOrder(pair AssetPair, direction OrderDirection, volume Decimal, trigger OrderPrice) *OrderBuilder {
+	b := newOrder(pair, StopLoss, direction, volume)
+	b.opts.Price = trigger
+	return b
+}
+
+// NewTakeProfitOrder returns a builder of a market order triggered when the price moves in favor of the position.
+func NewTakeProfitOrder(pair AssetPair, direction OrderDirection, volume Decimal, trigger OrderPrice) *OrderBuilder {
+	b := newOrder(pair, TakeProfit, direction, volume)
+	b.opts.Price = trigger
+	return b
+}
+
+// NewStopLossLimitOrder returns a builder of a limit order placed at the limit price once the stop loss is triggered.
+func NewStopLossLimitOrder(pair AssetPair, direction OrderDirection, volume Decimal, trigger, limit OrderPrice) *OrderBuilder {
+	b := newOrder(pair, StopLossLimit, direction, volume)
+	b.opts.Price = trigger
+	b.opts.Price2 = limit
+	return b
+}
+
+// NewTakeProfitLimitOrder returns a builder of a limit order placed at the limit price once the take profit is triggered.
+func NewTakeProfitLimitOrder(pair AssetPair, direction OrderDirection, volume Decimal, trigger, limit OrderPrice) *OrderBuilder {
+	b := newOrder(pair, TakeProfitLimit, direction, volume)
+	b.opts.Price = trigger
+	b.opts.Price2 = limit
+	return b
+}
+
+// NewTrailingStopOrder returns a builder of a market order triggered when the price moves back
+// from its best level by the offset, see TrailingOffset and TrailingPercent.
+func NewTrailingStopOrder(pair AssetPair, direction OrderDirection, volume Decimal, offset OrderPrice) *OrderBuilder {
+	b := newOrder(pair, TrailingStop, direction, volume)
+	b.opts.Price = offset
+	return b
+}
+
+// NewTrailingStopLimitOrder returns a builder of a limit order placed at the limit offset from the
+// trigger price once the trailing stop is triggered.
+func NewTrailingStopLimitOrder(pair AssetPair, direction OrderDirection, volume Decimal, offset, limitOffset OrderPrice) *OrderBuilder {
+	b := newOrder(pair, TrailingStopLimit, direction, volume)
+	b.opts.Price = offset
+	b.opts.Price2 = limitOffset
+	return b
+}
+
+// NewSettlePositionOrder returns a builder of an order that settles the open margin position
+// of the given leverage.
+func NewSettlePositionOrder(pair AssetPair, direction OrderDirection, leverage int) *OrderBuilder {
+	b := newOrder(pair, SettlePosition, direction, "0")
+	return b.WithLeverage(leverage)
+}
+
+// PostOnly makes a limit order be cancelled instead of taking liquidity.
+func (b *OrderBuilder) PostOnly() *OrderBuilder {
+	return b.withFlag(OrderFlagPost)
+}
+
+// FeeInBase charges the fee in the base asset.
+func (b *OrderBuilder) FeeInBase() *OrderBuilder {
+	return b.withFlag(OrderFlagFeeInBase)
+}
+
+// FeeInQuote charges the fee in the quote asset.
+func (b *OrderBuilder) FeeInQuote() *OrderBuilder {
+	return b.withFlag(OrderFlagFeeInQuote)
+}
+
+// NoMarketPriceProtection disables the market price protection of market orders.
+func (b *OrderBuilder) NoMarketPriceProtection() *OrderBuilder {
+	return b.withFlag(OrderFlagNoMarketPriceProtection)
+}
+
+// VolumeInQuote expresses the volume of a market buy order in the quote asset.
+func (b *OrderBuilder) VolumeInQuote() *OrderBuilder {
+	return b.withFlag(OrderFlagVolumeInQuote)
+}
+
+// ReduceOnly makes a margin order only reduce the open position.
+func (b *OrderBuilder) ReduceOnly() *OrderBuilder {
+	b.opts.ReduceOnly = true
+	return b
+}
+
+// GTC keeps the order open until it's cancelled. It's the default time in force.
+func (b *OrderBuilder) GTC() *OrderBuilder {
+	b.opts.TimeInForce = GoodTillCancelled
+	b.opts.Expiretm = OrderTime{}
+	return b
+}
+
+// IOC cancels the part of the order that is not filled immediately.
+func (b *OrderBuilder) IOC() *OrderBuilder {
+	b.opts.TimeInForce = ImmediateOrCancel
+	b.opts.Expiretm = OrderTime{}
+	return b
+}
+
+// GTD keeps the order open until the given time.
+func (b *OrderBuilder) GTD(expire time.Time) *OrderBuilder {
+	b.opts.TimeInForce = GoodTillDate
+	b.opts.Expiretm = OrderTimeAt(expire)
+	return b
+}
+
+// GTDIn keeps the order open for the given duration after Kraken receives it.
+func (b *OrderBuilder) GTDIn(d time.Duration) *OrderBuilder {
+	b.opts.TimeInForce = GoodTillDate
+	b.opts.Expiretm = OrderTimeIn(d)
+	return b
+}
+
+// StartAt schedules the order to be placed at the given time.
+func (b *OrderBuilder) StartAt(start time.Time) *OrderBuilder {
+	b.opts.Starttm = OrderTimeAt(start)
+	return b
+}
+
+// StartIn schedules the order to be placed the given duration after Kraken receives it.
+func (b *OrderBuilder) StartIn(d time.Duration) *OrderBuilder {
+	b.opts.Starttm = OrderTimeIn(d)
+	return b
+}
+
+// Deadline makes the matching engine reject the order if it's received after the given time,
+// which must be at most MaxOrderDeadline in the future.
+func (b *OrderBuilder) Deadline(deadline time.Time) *OrderBuilder {
+	b.opts.Deadline = OrderDeadline{deadline}
+	return b
+}
+
+// WithTrigger sets the price that triggers stop-loss, take-profit and trailing-stop orders.
+func (b *OrderBuilder) WithTrigger(trigger OrderTrigger) *OrderBuilder {
+	b.opts.Trigger = trigger
+	return b
+}
+
+// WithLeverage places the order on margin with the given leverage.
+func (b *OrderBuilder) WithLeverage(leverage int) *OrderBuilder {
+	b.opts.Leverage = strconv.Itoa(leverage) + ":1"
+	return b
+}
+
+// WithSelfTradePrevention sets which orders are cancelled when the order would match an order of the same user.
+func (b *OrderBuilder) WithSelfTradePrevention(stopType StopType) *OrderBuilder {
+	b.opts.StopType = stopType
+	return b
+}
+
+// WithUserRef sets the user reference of the order.
+func (b *OrderBuilder) WithUserRef(userRef int32) *OrderBuilder {
+	b.opts.UserRef = strconv.FormatInt(int64(userRef), 10)
+	return b
+}
+
+// WithClientOrderID sets the client order ID, which also makes the order safe to retry.
+func (b *OrderBuilder) WithClientOrderID(id string) *OrderBuilder {
+	b.opts.ClientOrderID = id
+	return b
+}
+
+// WithConditionalClose places an order in the opposite direction once the order is filled,
+// e.g. a stop-loss at price, or a stop-loss-limit at price with the limit price2.
+func (b *OrderBuilder) WithConditionalClose(orderType OrderType, price, price2 OrderPrice) *OrderBuilder {
+	b.opts.CloseOrderType = orderType
+	b.opts.ClosePrice = price
+	b.opts.ClosePrice2 = price2
+	return b
+}
+
+// ValidateOnly makes Kraken validate the order without placing it.
+func (b *OrderBuilder) ValidateOnly() *OrderBuilder {
+	b.opts.Validate = true
+	return b
+}
+
+// Build returns the parameters of the order, or a *ValidationError if they are not
+// consistent with the order type. Use an OrderValidator to also check the rules of the pair.
+func (b *OrderBuilder) Build() (AddOrderOpts, error) {
+	opts := b.opts
+	opts.OrderFlags = append(OrderFlags(nil), b.opts.OrderFlags...)
+
+	if err := checkOrderType(opts); err != nil {
+		return opts, err
+	}
+
+	if opts.DisplayVol != "" {
+		if opts.OrderType != Limit {
+			return opts, invalid(RuleOrderType, "displayvol", "is only allowed in limit orders", ErrInvalidArguments)
+		}
+		if opts.DisplayVol.Cmp(opts.Volume) >= 0 {
+			return opts, invalid(RuleOrderType, "displayvol", "must be lower than the volume", ErrInvalidArguments)
+		}
+	}
+
+	if opts.OrderFlags.Has(OrderFlagPost) && opts.OrderType != Limit {
+		return opts, invalid(RuleOrderType, "oflags", "post is only allowed in limit orders", ErrInvalidArguments)
+	}
+
+	if err := checkDeadline(opts.Deadline, time.Now()); err != nil {
+		return opts, err
+	}
+
+	if opts.CloseOrderType == Market || opts.CloseOrderType == SettlePosition {
+		return opts, invalid(RuleOrderType, "close[ordertype]", fmt.Sprintf("%s is not allowed in conditional close orders", opts.CloseOrderType), ErrInvalidArguments)
+	}
+
+	if opts.CloseOrderType != "" {
+		closeOpts := AddOrderOpts{
+			OrderType: opts.CloseOrderType,
+			Type:      opts.Type,
+			Price:     opts.ClosePrice,
+			Price2:    opts.ClosePrice2,
+		}
+		var verr *ValidationError
+		if err := checkOrderType(closeOpts); errors.As(err, &verr) {
+			verr.Field = "close[" + verr.Field + "]"
+			return opts, verr
+		}
+	}
+
+	return opts, nil
+}
+
+func (b *OrderBuilder) withFlag(flag OrderFlag) *OrderBuilder {
+	if !b.opts.OrderFlags.Has(flag) {
+		b.opts.OrderFlags = append(b.opts.OrderFlags, flag)
+	}
+	return b
+}
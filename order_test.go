@@ -0,0 +1,225 @@
+package kraken
+
+import (
+	"errors"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestOrderPrice(t *testing.T) {
+	tests := []struct {
+		name         string
+		p            OrderPrice
+		want         string
+		wantRelative bool
+	}{
+		{name: "absolute", p: AbsolutePrice("30300.1"), want: "30300.1"},
+		{name: "positive offset", p: RelativePrice("5"), want: "+5", wantRelative: true},
+		{name: "negative offset", p: RelativePrice("-5.5"), want: "-5.5", wantRelative: true},
+		{name: "positive percent", p: RelativePercent("1.5"), want: "+1.5%", wantRelative: true},
+		{name: "negative percent", p: RelativePercent("-2"), want: "-2%", wantRelative: true},
+		{name: "trailing offset", p: TrailingOffset("-10"), want: "+10", wantRelative: true},
+		{name: "trailing percent", p: TrailingPercent("0.5"), want: "+0.5%", wantRelative: true},
+		{name: "direction dependent", p: "#5", want: "#5", wantRelative: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.p.String(); got != tt.want {
+				t.Errorf("OrderPrice.String() = %v, want %v", got, tt.want)
+			}
+			if got := tt.p.IsRelative(); got != tt.wantRelative {
+				t.Errorf("OrderPrice.IsRelative() = %v, want %v", got, tt.wantRelative)
+			}
+			if _, ok := tt.p.Decimal(); ok == tt.wantRelative {
+				t.Errorf("OrderPrice.Decimal() ok = %v, want %v", ok, !tt.wantRelative)
+			}
+		})
+	}
+}
+
+func TestAddOrderOpts_encode(t *testing.T) {
+	deadline := time.Date(2024, 1, 2, 3, 4, 5, 250_000_000, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name    string
+		opts    AddOrderOpts
+		want    url.Values
+		wantErr bool
+	}{
+		{
+			name: "zero values are omitted",
+			opts: AddOrderOpts{Pair: "XXBTZUSD"},
+			want: url.Values{"pair": {"XXBTZUSD"}},
+		},
+		{
+			name: "flags, times and deadline",
+			opts: AddOrderOpts{
+				OrderFlags: OrderFlags{OrderFlagPost, OrderFlagFeeInQuote, OrderFlagPost},
+				Starttm:    OrderTimeIn(1500 * time.Millisecond),
+				Expiretm:   OrderTimeAt(time.Unix(1704164645, 0)),
+				Deadline:   OrderDeadline{deadline},
+			},
+			want: url.Values{
+				"oflags":   {"post,fciq"},
+				"starttm":  {"+2"},
+				"expiretm": {"1704164645"},
+				"deadline": {"2024-01-02T02:04:05.250Z"},
+			},
+		},
+		{
+			name:    "unknown flag",
+			opts:    AddOrderOpts{OrderFlags: OrderFlags{"fast"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := query.Values(tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("query.Values() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("query.Values() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderBuilder_Build(t *testing.T) {
+	expire := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		b        *OrderBuilder
+		want     AddOrderOpts
+		wantRule ValidationRule
+	}{
+		{
+			name: "market order",
+			b:    NewMarketOrder(XXBTZUSD, Buy, "100").VolumeInQuote().NoMarketPriceProtection(),
+			want: AddOrderOpts{Pair: "XXBTZUSD", OrderType: Market, Type: Buy, Volume: "100", OrderFlags: OrderFlags{OrderFlagVolumeInQuote, OrderFlagNoMarketPriceProtection}},
+		},
+		{
+			name: "post only limit order good till date",
+			b:    NewLimitOrder(XXBTZUSD, Buy, "0.5", "30300.1").PostOnly().PostOnly().GTD(expire).WithUserRef(42),
+			want: AddOrderOpts{
+				Pair: "XXBTZUSD", OrderType: Limit, Type: Buy, Volume: "0.5", Price: "30300.1",
+				OrderFlags: OrderFlags{OrderFlagPost}, TimeInForce: GoodTillDate, Expiretm: OrderTimeAt(expire), UserRef: "42",
+			},
+		},
+		{
+			name: "limit order with conditional close",
+			b: NewLimitOrder(XXBTZUSD, Buy, "0.5", RelativePercent("-1")).
+				WithConditionalClose(StopLossLimit, "29000", "28900").
+				WithClientOrderID("order-1"),
+			want: AddOrderOpts{
+				Pair: "XXBTZUSD", OrderType: Limit, Type: Buy, Volume: "0.5", Price: "-1%",
+				CloseOrderType: StopLossLimit, ClosePrice: "29000", ClosePrice2: "28900", ClientOrderID: "order-1",
+			},
+		},
+		{
+			name: "iceberg order",
+			b:    NewIcebergOrder(XXBTZUSD, Sell, "10", "1", "30300.1"),
+			want: AddOrderOpts{Pair: "XXBTZUSD", OrderType: Limit, Type: Sell, Volume: "10", DisplayVol: "1", Price: "30300.1"},
+		},
+		{
+			name: "stop loss order triggered by index",
+			b:    NewStopLossOrder(XXBTZUSD, Sell, "1", "29000").WithTrigger(Index).ReduceOnly(),
+			want: AddOrderOpts{Pair: "XXBTZUSD", OrderType: StopLoss, Type: Sell, Volume: "1", Price: "29000", Trigger: Index, ReduceOnly: true},
+		},
+		{
+			name: "take profit limit order",
+			b:    NewTakeProfitLimitOrder(XXBTZUSD, Sell, "1", "32000", "31900").IOC(),
+			want: AddOrderOpts{Pair: "XXBTZUSD", OrderType: TakeProfitLimit, Type: Sell, Volume: "1", Price: "32000", Price2: "31900", TimeInForce: ImmediateOrCancel},
+		},
+		{
+			name: "trailing stop limit order",
+			b:    NewTrailingStopLimitOrder(XXBTZUSD, Sell, "1", TrailingPercent("2"), RelativePrice("-10")),
+			want: AddOrderOpts{Pair: "XXBTZUSD", OrderType: TrailingStopLimit, Type: Sell, Volume: "1", Price: "+2%", Price2: "-10"},
+		},
+		{
+			name: "settle position",
+			b:    NewSettlePositionOrder(XXBTZUSD, Sell, 2).ValidateOnly(),
+			want: AddOrderOpts{Pair: "XXBTZUSD", OrderType: SettlePosition, Type: Sell, Volume: "0", Leverage: "2:1", Validate: true},
+		},
+		{
+			name: "scheduled order replaced by immediate or cancel",
+			b:    NewMarketOrder(XXBTZUSD, Buy, "1").StartIn(time.Minute).GTDIn(time.Hour).IOC(),
+			want: AddOrderOpts{Pair: "XXBTZUSD", OrderType: Market, Type: Buy, Volume: "1", Starttm: OrderTimeIn(time.Minute), TimeInForce: ImmediateOrCancel},
+		},
+		{
+			name:     "deadline too far",
+			b:        NewMarketOrder(XXBTZUSD, Buy, "1").Deadline(time.Now().Add(2 * MaxOrderDeadline)),
+			wantRule: RuleDeadline,
+		},
+		{
+			name:     "deadline elapsed",
+			b:        NewMarketOrder(XXBTZUSD, Buy, "1").Deadline(time.Now().Add(-time.Second)),
+			wantRule: RuleDeadline,
+		},
+		{
+			name:     "start in the past",
+			b:        NewMarketOrder(XXBTZUSD, Buy, "1").StartIn(-time.Minute),
+			wantRule: RuleOrderTime,
+		},
+		{
+			name:     "expire immediately",
+			b:        NewLimitOrder(XXBTZUSD, Buy, "1", "30300.1").GTDIn(0),
+			wantRule: RuleOrderTime,
+		},
+		{
+			name:     "trailing stop with absolute price",
+			b:        NewTrailingStopOrder(XXBTZUSD, Sell, "1", "29000"),
+			wantRule: RuleOrderType,
+		},
+		{
+			name:     "trigger in market order",
+			b:        NewMarketOrder(XXBTZUSD, Sell, "1").WithTrigger(Last),
+			wantRule: RuleTrigger,
+		},
+		{
+			name:     "post only market order",
+			b:        NewMarketOrder(XXBTZUSD, Sell, "1").PostOnly(),
+			wantRule: RuleOrderType,
+		},
+		{
+			name:     "display volume above volume",
+			b:        NewIcebergOrder(XXBTZUSD, Sell, "1", "2", "30300.1"),
+			wantRule: RuleOrderType,
+		},
+		{
+			name:     "market conditional close",
+			b:        NewLimitOrder(XXBTZUSD, Buy, "1", "30300.1").WithConditionalClose(Market, "", ""),
+			wantRule: RuleOrderType,
+		},
+		{
+			name:     "conditional close without price2",
+			b:        NewLimitOrder(XXBTZUSD, Buy, "1", "30300.1").WithConditionalClose(StopLossLimit, "29000", ""),
+			wantRule: RuleOrderType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.b.Build()
+
+			if tt.wantRule != "" {
+				var verr *ValidationError
+				if !errors.As(err, &verr) || verr.Rule != tt.wantRule {
+					t.Errorf("OrderBuilder.Build() error = %v, want rule %v", err, tt.wantRule)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("OrderBuilder.Build() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OrderBuilder.Build() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
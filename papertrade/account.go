@@ -0,0 +1,56 @@
+package papertrade
+
+import (
+	"context"
+
+	"github.com/jferrl/go-kraken"
+)
+
+// Balance returns the balances of the exchange, including the amounts held by open orders.
+func (e *Exchange) Balance(ctx context.Context) (kraken.AccountBalance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.checkTimer()
+
+	balances := make(kraken.AccountBalance, len(e.balances))
+	for asset, balance := range e.balances {
+		balances[asset] = balance
+	}
+
+	return balances, nil
+}
+
+// ExtendedBalance returns the balances of the exchange with the amounts held by open orders.
+// Credit is not simulated.
+func (e *Exchange) ExtendedBalance(ctx context.Context) (kraken.AccountExtendedBalance, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.checkTimer()
+
+	balances := make(kraken.AccountExtendedBalance, len(e.balances))
+	for asset, balance := range e.balances {
+		hold := e.holds[asset]
+		if hold == "" {
+			hold = "0"
+		}
+
+		balances[asset] = kraken.ExtendedBalance{
+			Balance:    balance,
+			Credit:     "0",
+			CreditUsed: "0",
+			HoldTrade:  hold,
+		}
+	}
+
+	return balances, nil
+}
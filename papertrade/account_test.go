@@ -0,0 +1,38 @@
+package papertrade
+
+import (
+	"context"
+	"testing"
+
+	"github.com/jferrl/go-kraken"
+)
+
+func TestExchange_ExtendedBalance(t *testing.T) {
+	e, _ := testExchange(t)
+
+	_, err := e.AddOrder(context.Background(), kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.Limit, Price: "31000.0", Volume: "0.25"})
+	if err != nil {
+		t.Fatalf("Exchange.AddOrder() error = %v", err)
+	}
+
+	got, err := e.ExtendedBalance(context.Background())
+	if err != nil {
+		t.Fatalf("Exchange.ExtendedBalance() error = %v", err)
+	}
+
+	// Sells are charged the fee in the base asset by default.
+	if b := got["XXBT"]; !b.Balance.Equal("1") || !b.HoldTrade.Equal("0.250650") || !b.Available().Equal("0.74935") {
+		t.Errorf("Exchange.ExtendedBalance() XXBT = %+v, want 0.25065 held", b)
+	}
+	if b := got["ZUSD"]; !b.Balance.Equal("100000") || !b.HoldTrade.IsZero() {
+		t.Errorf("Exchange.ExtendedBalance() ZUSD = %+v, want nothing held", b)
+	}
+
+	balance, err := e.Balance(context.Background())
+	if err != nil {
+		t.Fatalf("Exchange.Balance() error = %v", err)
+	}
+	if !balance["XXBT"].Equal("1") {
+		t.Errorf("Exchange.Balance() XXBT = %v, want %v", balance["XXBT"], "1")
+	}
+}
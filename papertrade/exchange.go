@@ -0,0 +1,211 @@
+// Package papertrade provides a simulated Kraken exchange implementing the MarketDataService,
+// AccountService and TradingService interfaces of the kraken package, so that strategies can
+// run locally without placing real orders.
+//
+// Orders are matched against order books fed with SetBook and UpdateBook, either replayed from
+// recorded data or followed live with Follow. Balances are charged the fees of the schedule of
+// the pair, selected by the volume traded through the exchange.
+package papertrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+// ErrNotSupported is returned for the features of the Kraken API that are not simulated,
+// e.g. margin trading or OHLC data.
+var ErrNotSupported = errors.New("not supported by the paper trading exchange")
+
+// Exchange is a simulated Kraken exchange. It is safe for concurrent use.
+type Exchange struct {
+	mu sync.Mutex
+
+	assets    kraken.Assets
+	pairs     kraken.AssetPairs
+	resolver  *kraken.Resolver
+	validator *kraken.OrderValidator
+	status    kraken.Status
+
+	books    map[kraken.AssetPair]*kraken.Book
+	balances map[kraken.Asset]kraken.Decimal
+	holds    map[kraken.Asset]kraken.Decimal
+	orders   []*order // Open orders, in the order they were placed.
+	trades   []Trade
+
+	feeVolume kraken.Decimal // Traded volume used to select the fee tier.
+	cancelAt  time.Time      // Time of the CancelAllOrdersAfter timer.
+	sequence  int
+
+	now func() time.Time
+}
+
+// Trade represents a fill of a simulated order.
+type Trade struct {
+	TransactionID kraken.TransactionID
+	Pair          kraken.AssetPair
+	Type          kraken.OrderDirection
+	Price         kraken.Decimal
+	Volume        kraken.Decimal
+	Cost          kraken.Decimal
+	Fee           kraken.Decimal
+	FeeAsset      kraken.Asset
+	Maker         bool
+	Time          time.Time
+}
+
+var (
+	_ kraken.MarketDataService = (*Exchange)(nil)
+	_ kraken.AccountService    = (*Exchange)(nil)
+	_ kraken.TradingService    = (*Exchange)(nil)
+)
+
+// New returns a new Exchange trading the given pairs, e.g. the result of MarketData.TradableAssetPairs,
+// funded with the given balances. Balances are keyed by the base and quote names of the pairs, e.g. XXBT and ZUSD.
+func New(assets kraken.Assets, pairs kraken.AssetPairs, balances kraken.AccountBalance) *Exchange {
+	e := &Exchange{
+		assets:    assets,
+		pairs:     pairs,
+		resolver:  kraken.NewResolver(assets, pairs),
+		validator: kraken.NewOrderValidator(pairs),
+		status:    kraken.Online,
+		books:     map[kraken.AssetPair]*kraken.Book{},
+		balances:  map[kraken.Asset]kraken.Decimal{},
+		holds:     map[kraken.Asset]kraken.Decimal{},
+		feeVolume: "0",
+		now:       time.Now,
+	}
+
+	for asset, balance := range balances {
+		e.balances[asset] = balance
+	}
+
+	e.validator.SetSystemStatus(kraken.Online)
+
+	return e
+}
+
+// SetSystemStatus sets the status of the exchange, which restricts the orders accepted
+// the same way Kraken does.
+func (e *Exchange) SetSystemStatus(status kraken.Status) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.status = status
+	e.validator.SetSystemStatus(status)
+}
+
+// SetFeeVolume sets the 30 day trading volume, in USD, used to select the fee tier. The cost of
+// the simulated trades is added to it, converted to USD at the mid price of the book of the quote
+// asset against USD. Trades whose quote asset has no such book are not counted.
+func (e *Exchange) SetFeeVolume(volume kraken.Decimal) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.feeVolume = volume
+}
+
+// SetBook replaces the order book of the pair and matches the open orders against it.
+func (e *Exchange) SetBook(pair kraken.AssetPair, b *kraken.Book) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, _, err := e.pair(string(pair))
+	if err != nil {
+		return err
+	}
+
+	e.books[p] = kraken.NewBook(b.Asks, b.Bids)
+	e.match(p)
+
+	return nil
+}
+
+// UpdateBook applies a streamed update to the order book of the pair, see Book.Update,
+// and matches the open orders against it.
+func (e *Exchange) UpdateBook(pair kraken.AssetPair, side kraken.OrderDirection, depth int, levels ...kraken.BookLevel) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, _, err := e.pair(string(pair))
+	if err != nil {
+		return err
+	}
+
+	b, ok := e.books[p]
+	if !ok {
+		b = kraken.NewBook(nil, nil)
+		e.books[p] = b
+	}
+
+	b.Update(side, depth, levels...)
+	e.match(p)
+
+	return nil
+}
+
+// Follow feeds the exchange with the order books of the pairs fetched from m, e.g. the market
+// data of a kraken.Client, every interval until ctx is done or a request fails.
+func (e *Exchange) Follow(ctx context.Context, m kraken.MarketDataService, interval time.Duration, count int, pairs ...kraken.AssetPair) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		for _, pair := range pairs {
+			ob, err := m.OrderBook(ctx, kraken.OrderBookOpts{Pair: pair, Count: count})
+			if err != nil {
+				return err
+			}
+
+			b, err := ob.Book()
+			if err != nil {
+				return fmt.Errorf("order book of %s: %w", pair, err)
+			}
+
+			if err := e.SetBook(pair, b); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Trades returns the fills of the simulated orders, oldest first.
+func (e *Exchange) Trades() []Trade {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]Trade(nil), e.trades...)
+}
+
+// pair resolves the name of a pair to the canonical one.
+func (e *Exchange) pair(name string) (kraken.AssetPair, kraken.AssetPairInfo, error) {
+	p, ok := e.resolver.Pair(name)
+	if !ok {
+		return "", kraken.AssetPairInfo{}, fmt.Errorf("%w: %s", kraken.ErrUnknownAssetPair, name)
+	}
+	return p, e.pairs[p], nil
+}
+
+// checkTimer cancels every open order once the CancelAllOrdersAfter timer has triggered.
+func (e *Exchange) checkTimer() {
+	if e.cancelAt.IsZero() || e.now().Before(e.cancelAt) {
+		return
+	}
+
+	e.cancelAt = time.Time{}
+	e.cancelAll()
+}
+
+func add(m map[kraken.Asset]kraken.Decimal, asset kraken.Asset, amount kraken.Decimal) {
+	m[asset] = m[asset].Add(amount)
+}
@@ -0,0 +1,128 @@
+package papertrade
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func testExchange(t *testing.T) (*Exchange, *fakeClock) {
+	t.Helper()
+
+	assets := kraken.Assets{
+		"XXBT": {Altname: "XBT", AssetClass: kraken.Currency, Decimals: 10},
+		"ZUSD": {Altname: "USD", AssetClass: kraken.Currency, Decimals: 4},
+	}
+
+	pairs := kraken.AssetPairs{
+		"XXBTZUSD": {
+			Altname:      "XBTUSD",
+			WSName:       "XBT/USD",
+			Base:         "XXBT",
+			Quote:        "ZUSD",
+			PairDecimals: 1,
+			CostDecimals: 5,
+			LotDecimals:  8,
+			OrderMin:     "0.0001",
+			TickSize:     "0.1",
+			Fees:         []kraken.FeeTuple{{0, 0.26}, {50000, 0.24}},
+			FeesMaker:    []kraken.FeeTuple{{0, 0.16}, {50000, 0.14}},
+			Status:       kraken.Online,
+		},
+	}
+
+	e := New(assets, pairs, kraken.AccountBalance{"XXBT": "1", "ZUSD": "100000"})
+
+	clock := &fakeClock{now: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	e.now = clock.Now
+
+	book := kraken.NewBook(
+		[]kraken.BookLevel{{Price: "30000.0", Volume: "1"}, {Price: "30010.0", Volume: "2"}},
+		[]kraken.BookLevel{{Price: "29990.0", Volume: "1"}, {Price: "29980.0", Volume: "2"}},
+	)
+	if err := e.SetBook("XBT/USD", book); err != nil {
+		t.Fatalf("Exchange.SetBook() error = %v", err)
+	}
+
+	return e, clock
+}
+
+// orderBookFeed serves the order books of a MarketDataService from a list of snapshots.
+type orderBookFeed struct {
+	kraken.MarketDataService
+
+	books []*kraken.OrderBook
+	calls int
+}
+
+func (f *orderBookFeed) OrderBook(_ context.Context, _ kraken.OrderBookOpts) (*kraken.OrderBook, error) {
+	if f.calls >= len(f.books) {
+		return nil, kraken.ErrServiceUnavailable
+	}
+	f.calls++
+	return f.books[f.calls-1], nil
+}
+
+func TestExchange_SetBook(t *testing.T) {
+	e, _ := testExchange(t)
+
+	if err := e.SetBook("FOOBAR", kraken.NewBook(nil, nil)); !errors.Is(err, kraken.ErrUnknownAssetPair) {
+		t.Errorf("Exchange.SetBook() error = %v, want %v", err, kraken.ErrUnknownAssetPair)
+	}
+
+	if got := e.books["XXBTZUSD"].Asks[0].Price; got != "30000.0" {
+		t.Errorf("best ask = %v, want %v", got, "30000.0")
+	}
+}
+
+func TestExchange_UpdateBook(t *testing.T) {
+	e, _ := testExchange(t)
+
+	err := e.UpdateBook("XBTUSD", kraken.Sell, 0,
+		kraken.BookLevel{Price: "30000.0", Volume: "0"},
+		kraken.BookLevel{Price: "30005.0", Volume: "3"},
+	)
+	if err != nil {
+		t.Fatalf("Exchange.UpdateBook() error = %v", err)
+	}
+
+	if got := e.books["XXBTZUSD"].Asks[0]; got.Price != "30005.0" || got.Volume != "3" {
+		t.Errorf("best ask = %+v, want 3 @ 30005.0", got)
+	}
+}
+
+func TestExchange_Follow(t *testing.T) {
+	e, _ := testExchange(t)
+
+	feed := &orderBookFeed{books: []*kraken.OrderBook{
+		{
+			Asks: []kraken.OrderBookEntries{{"31000.0", "1.5", float64(1704164645)}},
+			Bids: []kraken.OrderBookEntries{{"30990.0", "2.5", float64(1704164645)}},
+		},
+	}}
+
+	err := e.Follow(context.Background(), feed, time.Millisecond, 10, "XXBTZUSD")
+	if !errors.Is(err, kraken.ErrServiceUnavailable) {
+		t.Errorf("Exchange.Follow() error = %v, want %v", err, kraken.ErrServiceUnavailable)
+	}
+
+	b := e.books["XXBTZUSD"]
+	if len(b.Asks) != 1 || b.Asks[0].Price != "31000.0" || len(b.Bids) != 1 || b.Bids[0].Price != "30990.0" {
+		t.Errorf("book = %+v, want the followed book", b)
+	}
+}
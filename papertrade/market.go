@@ -0,0 +1,197 @@
+package papertrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+// defaultBookCount is the number of levels returned by OrderBook, as in the Kraken API.
+const defaultBookCount = 100
+
+// Time returns the time of the exchange.
+func (e *Exchange) Time(ctx context.Context) (*kraken.ServerTime, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	now := e.now()
+
+	return &kraken.ServerTime{UnixTime: now.Unix(), Rfc1123: now.UTC().Format(time.RFC1123)}, nil
+}
+
+// SystemStatus returns the status set with SetSystemStatus.
+func (e *Exchange) SystemStatus(ctx context.Context) (*kraken.SystemStatus, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return &kraken.SystemStatus{Status: e.status, Timestamp: e.now().UTC().Format(time.RFC3339)}, nil
+}
+
+// Assets returns the assets of the exchange.
+func (e *Exchange) Assets(ctx context.Context, opts kraken.AssetsOpts) (kraken.Assets, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	names := opts.Assets
+	if len(names) == 0 {
+		for a := range e.assets {
+			names = append(names, a)
+		}
+	}
+
+	assets := kraken.Assets{}
+	for _, name := range names {
+		a, ok := e.resolver.Asset(string(name))
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", kraken.ErrUnknownAsset, name)
+		}
+
+		info := e.assets[a]
+		if opts.Class != "" && info.AssetClass != opts.Class {
+			continue
+		}
+		assets[a] = info
+	}
+
+	return assets, nil
+}
+
+// TradableAssetPairs returns the asset pairs of the exchange.
+func (e *Exchange) TradableAssetPairs(ctx context.Context, opts kraken.TradableAssetPairsOpts) (kraken.AssetPairs, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(opts.Pairs) == 0 {
+		pairs := make(kraken.AssetPairs, len(e.pairs))
+		for p, info := range e.pairs {
+			pairs[p] = info
+		}
+		return pairs, nil
+	}
+
+	pairs := kraken.AssetPairs{}
+	for _, name := range opts.Pairs {
+		p, info, err := e.pair(string(name))
+		if err != nil {
+			return nil, err
+		}
+		pairs[p] = info
+	}
+
+	return pairs, nil
+}
+
+// TickerInformation returns the best ask and bid of the order books and the last simulated trade.
+// Statistics of the market, e.g. the volume of the day, are not available.
+func (e *Exchange) TickerInformation(ctx context.Context, opts kraken.TickerInformationOpts) (kraken.Tickers, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	var pairs []kraken.AssetPair
+	for _, name := range opts.Pairs {
+		p, _, err := e.pair(string(name))
+		if err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, p)
+	}
+	if len(pairs) == 0 {
+		for p := range e.books {
+			pairs = append(pairs, p)
+		}
+	}
+
+	tickers := kraken.Tickers{}
+	for _, p := range pairs {
+		var t kraken.AssetTickerInfo
+
+		if b, ok := e.books[p]; ok {
+			if ask, ok := b.BestAsk(); ok {
+				t.Ask = []kraken.Decimal{ask.Price, ask.Volume.Truncate(0), ask.Volume}
+			}
+			if bid, ok := b.BestBid(); ok {
+				t.Bid = []kraken.Decimal{bid.Price, bid.Volume.Truncate(0), bid.Volume}
+			}
+		}
+
+		if last, ok := e.lastTrade(p); ok {
+			t.Last = []kraken.Decimal{last.Price, last.Volume}
+		}
+
+		tickers[p] = t
+	}
+
+	return tickers, nil
+}
+
+// OHCLData is not supported.
+func (e *Exchange) OHCLData(_ context.Context, _ kraken.OHCLDataOpts) (*kraken.OHCL, error) {
+	return nil, fmt.Errorf("OHCL data: %w", ErrNotSupported)
+}
+
+// OrderBook returns the order book of the pair, as fed to the exchange and consumed by the simulated orders.
+func (e *Exchange) OrderBook(ctx context.Context, opts kraken.OrderBookOpts) (*kraken.OrderBook, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	p, _, err := e.pair(string(opts.Pair))
+	if err != nil {
+		return nil, err
+	}
+
+	count := opts.Count
+	if count <= 0 {
+		count = defaultBookCount
+	}
+
+	ob := &kraken.OrderBook{}
+	if b, ok := e.books[p]; ok {
+		ob.Asks = e.bookEntries(b.Asks, count)
+		ob.Bids = e.bookEntries(b.Bids, count)
+	}
+
+	return ob, nil
+}
+
+func (e *Exchange) bookEntries(levels []kraken.BookLevel, count int) []kraken.OrderBookEntries {
+	if len(levels) > count {
+		levels = levels[:count]
+	}
+
+	entries := make([]kraken.OrderBookEntries, 0, len(levels))
+	for _, l := range levels {
+		ts := l.Time
+		if ts.IsZero() {
+			ts = e.now()
+		}
+		entries = append(entries, kraken.OrderBookEntries{string(l.Price), string(l.Volume), float64(ts.Unix())})
+	}
+
+	return entries
+}
+
+func (e *Exchange) lastTrade(pair kraken.AssetPair) (Trade, bool) {
+	for i := len(e.trades) - 1; i >= 0; i-- {
+		if e.trades[i].Pair == pair {
+			return e.trades[i], true
+		}
+	}
+	return Trade{}, false
+}
@@ -0,0 +1,127 @@
+package papertrade
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+func TestExchange_Time(t *testing.T) {
+	e, _ := testExchange(t)
+
+	got, err := e.Time(context.Background())
+	if err != nil {
+		t.Fatalf("Exchange.Time() error = %v", err)
+	}
+
+	want := &kraken.ServerTime{UnixTime: 1704164645, Rfc1123: "Tue, 02 Jan 2024 03:04:05 UTC"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Exchange.Time() = %v, want %v", got, want)
+	}
+}
+
+func TestExchange_SystemStatus(t *testing.T) {
+	e, _ := testExchange(t)
+	e.SetSystemStatus(kraken.CancelOnly)
+
+	got, err := e.SystemStatus(context.Background())
+	if err != nil {
+		t.Fatalf("Exchange.SystemStatus() error = %v", err)
+	}
+	if got.Status != kraken.CancelOnly {
+		t.Errorf("Exchange.SystemStatus() = %v, want %v", got.Status, kraken.CancelOnly)
+	}
+
+	_, err = e.AddOrder(context.Background(), kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Market, Volume: "0.1"})
+	if !errors.Is(err, kraken.ErrMarketCancelOnly) {
+		t.Errorf("Exchange.AddOrder() error = %v, want %v", err, kraken.ErrMarketCancelOnly)
+	}
+}
+
+func TestExchange_Assets(t *testing.T) {
+	e, _ := testExchange(t)
+
+	got, err := e.Assets(context.Background(), kraken.AssetsOpts{Assets: []kraken.Asset{"BTC"}})
+	if err != nil {
+		t.Fatalf("Exchange.Assets() error = %v", err)
+	}
+	if _, ok := got["XXBT"]; !ok || len(got) != 1 {
+		t.Errorf("Exchange.Assets() = %v, want XXBT", got)
+	}
+
+	if _, err := e.Assets(context.Background(), kraken.AssetsOpts{Assets: []kraken.Asset{"FOO"}}); !errors.Is(err, kraken.ErrUnknownAsset) {
+		t.Errorf("Exchange.Assets() error = %v, want %v", err, kraken.ErrUnknownAsset)
+	}
+}
+
+func TestExchange_TradableAssetPairs(t *testing.T) {
+	e, _ := testExchange(t)
+
+	got, err := e.TradableAssetPairs(context.Background(), kraken.TradableAssetPairsOpts{Pairs: []kraken.AssetPair{"BTC/USD"}})
+	if err != nil {
+		t.Fatalf("Exchange.TradableAssetPairs() error = %v", err)
+	}
+	if got.Info("XXBTZUSD").Altname != "XBTUSD" {
+		t.Errorf("Exchange.TradableAssetPairs() = %v, want XXBTZUSD", got)
+	}
+}
+
+func TestExchange_TickerInformation(t *testing.T) {
+	e, _ := testExchange(t)
+
+	_, err := e.AddOrder(context.Background(), kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Market, Volume: "0.5"})
+	if err != nil {
+		t.Fatalf("Exchange.AddOrder() error = %v", err)
+	}
+
+	got, err := e.TickerInformation(context.Background(), kraken.TickerInformationOpts{})
+	if err != nil {
+		t.Fatalf("Exchange.TickerInformation() error = %v", err)
+	}
+
+	want := kraken.Tickers{
+		"XXBTZUSD": {
+			Ask:  []kraken.Decimal{"30000.0", "0", "0.5"},
+			Bid:  []kraken.Decimal{"29990.0", "1", "1"},
+			Last: []kraken.Decimal{"30000.0", "0.5"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Exchange.TickerInformation() = %v, want %v", got, want)
+	}
+}
+
+func TestExchange_OHCLData(t *testing.T) {
+	e, _ := testExchange(t)
+
+	if _, err := e.OHCLData(context.Background(), kraken.OHCLDataOpts{Pair: "XXBTZUSD"}); !errors.Is(err, ErrNotSupported) {
+		t.Errorf("Exchange.OHCLData() error = %v, want %v", err, ErrNotSupported)
+	}
+}
+
+func TestExchange_OrderBook(t *testing.T) {
+	e, _ := testExchange(t)
+
+	ob, err := e.OrderBook(context.Background(), kraken.OrderBookOpts{Pair: "XBTUSD", Count: 1})
+	if err != nil {
+		t.Fatalf("Exchange.OrderBook() error = %v", err)
+	}
+
+	got, err := ob.Book()
+	if err != nil {
+		t.Fatalf("OrderBook.Book() error = %v", err)
+	}
+
+	now := time.Unix(e.now().Unix(), 0)
+	want := kraken.NewBook(
+		[]kraken.BookLevel{{Price: "30000.0", Volume: "1", Time: now}},
+		[]kraken.BookLevel{{Price: "29990.0", Volume: "1", Time: now}},
+	)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Exchange.OrderBook() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,728 @@
+package papertrade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+// order is an open simulated order.
+type order struct {
+	id          kraken.TransactionID
+	opts        kraken.AddOrderOpts
+	pair        kraken.AssetPair
+	info        kraken.AssetPairInfo
+	base, quote kraken.Asset
+
+	limit     kraken.Decimal // Limit price, empty for orders filled at the market price.
+	trigger   kraken.Decimal // Trigger price of stop-loss and take-profit orders, empty once triggered.
+	remaining kraken.Decimal
+	expire    time.Time
+
+	hold      kraken.Decimal // Amount of holdAsset held until the order is closed.
+	holdAsset kraken.Asset
+
+	opened   time.Time
+	price    kraken.Decimal // Primary price of the order, its limit or trigger price.
+	executed kraken.Decimal
+	cost     kraken.Decimal
+	fee      kraken.Decimal
+}
+
+// AddOrder places a simulated order. Market, limit, stop-loss, take-profit and their limit
+// variants are supported. Market orders are filled immediately against the order book and
+// any volume left is cancelled. The other orders are held until they are filled or cancelled.
+//
+// Stop-loss and take-profit orders are triggered by the best price of the side of the book
+// they would be filled against, i.e. the best bid for sells and the best ask for buys.
+// Relative prices are resolved against the last simulated trade or the mid price of the book.
+func (e *Exchange) AddOrder(ctx context.Context, opts kraken.AddOrderOpts) (*kraken.OrderCreation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.checkTimer()
+
+	opts, err := e.validator.Validate(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := supported(opts); err != nil {
+		return nil, err
+	}
+
+	pair, info, err := e.pair(opts.Pair)
+	if err != nil {
+		return nil, err
+	}
+
+	o := &order{
+		opts:      opts,
+		pair:      pair,
+		info:      info,
+		base:      kraken.Asset(info.Base),
+		quote:     kraken.Asset(info.Quote),
+		remaining: opts.Volume,
+		executed:  "0",
+		cost:      "0",
+		fee:       "0",
+	}
+
+	if err := e.resolvePrices(o); err != nil {
+		return nil, err
+	}
+
+	if o.expire, err = e.expiration(opts.Expiretm); err != nil {
+		return nil, err
+	}
+
+	asset, amount, err := e.required(o)
+	if err != nil {
+		return nil, err
+	}
+
+	available := e.balances[asset].Sub(e.holds[asset])
+	if available.Cmp(amount) < 0 {
+		return nil, fmt.Errorf("%w: %s %s required, %s available", kraken.ErrInsufficientFunds, amount, asset, available)
+	}
+
+	creation := &kraken.OrderCreation{Description: kraken.OrderDescription{Order: describe(o)}}
+	if opts.Validate {
+		return creation, nil
+	}
+
+	e.sequence++
+	o.id = kraken.TransactionID(fmt.Sprintf("OPAPER-%06d", e.sequence))
+	o.opened = e.now()
+	creation.Transaction = []kraken.TransactionID{o.id}
+
+	// Market orders take the liquidity available and are never held.
+	if opts.OrderType == kraken.Market {
+		e.take(o, false)
+		return creation, nil
+	}
+
+	o.hold, o.holdAsset = amount, asset
+	add(e.holds, asset, amount)
+	e.orders = append(e.orders, o)
+
+	if opts.OrderType == kraken.Limit {
+		if opts.OrderFlags.Has(kraken.OrderFlagPost) && e.crosses(o) {
+			// Post-only orders that would take liquidity are cancelled, as Kraken does.
+			e.close(o)
+			return creation, nil
+		}
+
+		e.take(o, false)
+
+		if o.remaining.Sign() <= 0 || opts.TimeInForce == kraken.ImmediateOrCancel {
+			e.close(o)
+		}
+		return creation, nil
+	}
+
+	e.match(pair)
+
+	return creation, nil
+}
+
+// OpenOrders returns the open orders, optionally filtered by user reference or client order ID.
+// The trades of the orders are not reported.
+func (e *Exchange) OpenOrders(ctx context.Context, opts kraken.OpenOrdersOpts) (*kraken.OpenOrders, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.checkTimer()
+
+	v := &kraken.OpenOrders{Open: make(map[kraken.TransactionID]kraken.OrderInfo)}
+
+	for _, o := range e.orders {
+		if (opts.UserRef != "" && o.opts.UserRef != opts.UserRef) ||
+			(opts.ClientOrderID != "" && o.opts.ClientOrderID != opts.ClientOrderID) {
+			continue
+		}
+		v.Open[o.id] = info(o)
+	}
+
+	return v, nil
+}
+
+// CancelOrder cancels the open orders with the given transaction ID, user reference or client order ID.
+func (e *Exchange) CancelOrder(ctx context.Context, opts kraken.CancelOrderOpts) (*kraken.OrderCancelation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	id := opts.TransactionID
+	if id == "" {
+		return nil, fmt.Errorf("%w: txid is required", kraken.ErrInvalidArguments)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.checkTimer()
+
+	var count int
+	for _, o := range append([]*order(nil), e.orders...) {
+		if string(o.id) == id || (o.opts.ClientOrderID != "" && o.opts.ClientOrderID == id) ||
+			(o.opts.UserRef != "" && o.opts.UserRef == id) {
+			e.close(o)
+			count++
+		}
+	}
+
+	if count == 0 {
+		return nil, fmt.Errorf("%w: %s", kraken.ErrOrderNotFound, id)
+	}
+
+	return &kraken.OrderCancelation{Count: count}, nil
+}
+
+// CancelAllOrders cancels every open order.
+func (e *Exchange) CancelAllOrders(ctx context.Context) (*kraken.OrderCancelation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.checkTimer()
+
+	return &kraken.OrderCancelation{Count: e.cancelAll()}, nil
+}
+
+// CancelAllOrdersAfter sets the timer that cancels every open order, or disables it with a zero timeout.
+// The orders are cancelled by the first call to the exchange made once the timer has triggered.
+func (e *Exchange) CancelAllOrdersAfter(ctx context.Context, opts kraken.CancelAllOrdersAfterOpts) (*kraken.TriggeredOrderCancellation, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	if opts.Timeout < 0 {
+		return nil, fmt.Errorf("timeout %v must not be negative", opts.Timeout)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.checkTimer()
+
+	now := e.now()
+
+	v := &kraken.TriggeredOrderCancellation{CurrentTime: now.UTC().Format(time.RFC3339), TriggerTime: "0"}
+
+	if opts.Timeout == 0 {
+		e.cancelAt = time.Time{}
+		return v, nil
+	}
+
+	// Kraken counts the timeout in whole seconds.
+	seconds := (opts.Timeout + time.Second - 1) / time.Second
+	e.cancelAt = now.Add(seconds * time.Second)
+	v.TriggerTime = e.cancelAt.UTC().Format(time.RFC3339)
+
+	return v, nil
+}
+
+// match triggers and fills the open orders of the pair against its order book.
+func (e *Exchange) match(pair kraken.AssetPair) {
+	now := e.now()
+
+	for _, o := range append([]*order(nil), e.orders...) {
+		if o.pair != pair {
+			continue
+		}
+
+		if !o.expire.IsZero() && !now.Before(o.expire) {
+			e.close(o)
+			continue
+		}
+
+		switch {
+		case o.trigger != "":
+			if !e.triggered(o) {
+				continue
+			}
+			o.trigger = ""
+
+			// Triggered orders take liquidity, stop-loss and take-profit orders at any price.
+			e.take(o, false)
+			if o.limit == "" {
+				e.close(o)
+				continue
+			}
+		default:
+			// Resting limit orders are filled at their price when the book crosses it.
+			e.take(o, true)
+		}
+
+		if o.remaining.Sign() <= 0 {
+			e.close(o)
+		}
+	}
+}
+
+// take fills the order against the levels of the book up to its limit price, removing the volume
+// taken from the book. Makers are filled at their limit price and takers at the price of each level.
+func (e *Exchange) take(o *order, maker bool) {
+	b, ok := e.books[o.pair]
+	if !ok {
+		return
+	}
+
+	// Buys take the asks, which are updated as the side of the sell orders.
+	levels, side := b.Asks, kraken.Sell
+	if o.opts.Type == kraken.Sell {
+		levels, side = b.Bids, kraken.Buy
+	}
+
+	for _, l := range append([]kraken.BookLevel(nil), levels...) {
+		if o.remaining.Sign() <= 0 || (o.limit != "" && !crosses(o.opts.Type, l.Price, o.limit)) {
+			break
+		}
+
+		volume := l.Volume
+		if volume.Cmp(o.remaining) > 0 {
+			volume = o.remaining
+		}
+
+		price := l.Price
+		if maker {
+			price = o.limit
+		}
+
+		e.fill(o, price, volume, maker)
+
+		b.Update(side, 0, kraken.BookLevel{Price: l.Price, Volume: l.Volume.Sub(volume), Time: l.Time})
+	}
+}
+
+// fill executes part of the order, releasing the amount held for it and charging its fee.
+func (e *Exchange) fill(o *order, price, volume kraken.Decimal, maker bool) {
+	if o.hold != "" {
+		release := o.hold
+		if volume.Cmp(o.remaining) < 0 {
+			release, _ = o.hold.Mul(volume).Div(o.remaining, 18)
+		}
+		o.hold = o.hold.Sub(release)
+		add(e.holds, o.holdAsset, release.Neg())
+	}
+
+	cost := price.Mul(volume)
+	rate := feeRate(o.info, e.feeVolume, maker)
+
+	feeAsset, fee := o.quote, cost.Mul(rate)
+	if feeInBase(o.opts) {
+		feeAsset, fee = o.base, volume.Mul(rate)
+	}
+	if info, ok := e.assets[feeAsset]; ok && info.Decimals > 0 {
+		fee = fee.Round(int32(info.Decimals))
+	}
+
+	if o.opts.Type == kraken.Buy {
+		add(e.balances, o.base, volume)
+		add(e.balances, o.quote, cost.Neg())
+	} else {
+		add(e.balances, o.base, volume.Neg())
+		add(e.balances, o.quote, cost)
+	}
+	add(e.balances, feeAsset, fee.Neg())
+
+	o.remaining = o.remaining.Sub(volume)
+	o.executed = o.executed.Add(volume)
+	o.cost = o.cost.Add(cost)
+	o.fee = o.fee.Add(fee)
+	if usd, ok := e.usdValue(o.quote, cost); ok {
+		e.feeVolume = e.feeVolume.Add(usd)
+	}
+
+	e.trades = append(e.trades, Trade{
+		TransactionID: o.id,
+		Pair:          o.pair,
+		Type:          o.opts.Type,
+		Price:         price,
+		Volume:        volume,
+		Cost:          cost,
+		Fee:           fee,
+		FeeAsset:      feeAsset,
+		Maker:         maker,
+		Time:          e.now(),
+	})
+}
+
+// close removes the order from the open orders and releases the amount held for it.
+func (e *Exchange) close(o *order) {
+	for i, open := range e.orders {
+		if open == o {
+			e.orders = append(e.orders[:i], e.orders[i+1:]...)
+			break
+		}
+	}
+
+	if o.hold != "" {
+		add(e.holds, o.holdAsset, o.hold.Neg())
+		o.hold = "0"
+	}
+}
+
+func (e *Exchange) cancelAll() int {
+	count := len(e.orders)
+	for len(e.orders) > 0 {
+		e.close(e.orders[0])
+	}
+	return count
+}
+
+// triggered returns true if the best price the order would be filled at has reached its trigger price.
+func (e *Exchange) triggered(o *order) bool {
+	b, ok := e.books[o.pair]
+	if !ok {
+		return false
+	}
+
+	best, ok := b.BestAsk()
+	if o.opts.Type == kraken.Sell {
+		best, ok = b.BestBid()
+	}
+	if !ok {
+		return false
+	}
+
+	// Stop-loss orders are triggered when the price moves against a position closed by the order,
+	// e.g. a sell when the price falls, and take-profit orders when it moves in its favor.
+	falling := best.Price.Cmp(o.trigger) <= 0
+	rising := best.Price.Cmp(o.trigger) >= 0
+
+	stopLoss := o.opts.OrderType == kraken.StopLoss || o.opts.OrderType == kraken.StopLossLimit
+	if (o.opts.Type == kraken.Sell) == stopLoss {
+		return falling
+	}
+	return rising
+}
+
+// crosses returns true if the limit order would take liquidity from the book.
+func (e *Exchange) crosses(o *order) bool {
+	b, ok := e.books[o.pair]
+	if !ok {
+		return false
+	}
+
+	best, ok := b.BestAsk()
+	if o.opts.Type == kraken.Sell {
+		best, ok = b.BestBid()
+	}
+	return ok && crosses(o.opts.Type, best.Price, o.limit)
+}
+
+// resolvePrices sets the limit and trigger prices of the order, resolving relative prices.
+func (e *Exchange) resolvePrices(o *order) error {
+	reference, err := e.reference(o.pair)
+	if err != nil && (o.opts.Price.IsRelative() || o.opts.Price2.IsRelative()) {
+		return err
+	}
+
+	price, err := resolvePrice(o.opts.Price, reference, o.info)
+	if err != nil {
+		return err
+	}
+
+	o.price = price
+
+	switch o.opts.OrderType {
+	case kraken.Limit:
+		o.limit = price
+	case kraken.StopLoss, kraken.TakeProfit:
+		o.trigger = price
+	case kraken.StopLossLimit, kraken.TakeProfitLimit:
+		o.trigger = price
+		// The limit price of a triggered order is relative to its trigger price.
+		if o.limit, err = resolvePrice(o.opts.Price2, price, o.info); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reference returns the price relative prices are resolved against: the last simulated trade
+// or the mid price of the book.
+func (e *Exchange) reference(pair kraken.AssetPair) (kraken.Decimal, error) {
+	if last, ok := e.lastTrade(pair); ok {
+		return last.Price, nil
+	}
+
+	if b, ok := e.books[pair]; ok {
+		if mid, ok := b.Mid(); ok {
+			return mid, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: no price to resolve relative prices of %s", kraken.ErrInvalidPrice, pair)
+}
+
+// required returns the amount of the asset the order needs to be placed, including its fee.
+func (e *Exchange) required(o *order) (kraken.Asset, kraken.Decimal, error) {
+	price := o.limit
+	if price == "" {
+		price = o.trigger
+	}
+
+	// Market orders are estimated from the levels they would take.
+	if price == "" {
+		b, ok := e.books[o.pair]
+		if !ok {
+			return "", "", fmt.Errorf("%w: no order book for %s", kraken.ErrInsufficientLiquidity, o.pair)
+		}
+
+		f, err := b.Fill(o.opts.Type, o.remaining)
+		switch {
+		case err == nil:
+			price = f.AveragePrice
+		case errors.Is(err, kraken.ErrInsufficientLiquidity):
+			// The volume left is cancelled, the order is estimated at the last level it takes.
+			level, ok := worst(b, o.opts.Type)
+			if !ok {
+				return "", "", fmt.Errorf("%w: %s", kraken.ErrInsufficientLiquidity, o.pair)
+			}
+			price = level.Price
+		default:
+			return "", "", err
+		}
+	}
+
+	rate := feeRate(o.info, e.feeVolume, false)
+
+	if o.opts.Type == kraken.Buy {
+		amount := price.Mul(o.remaining)
+		if !feeInBase(o.opts) {
+			amount = amount.Add(amount.Mul(rate))
+		}
+		return o.quote, amount, nil
+	}
+
+	amount := o.remaining
+	if feeInBase(o.opts) {
+		amount = amount.Add(amount.Mul(rate))
+	}
+	return o.base, amount, nil
+}
+
+// expiration returns the time a GTD order expires.
+func (e *Exchange) expiration(t kraken.OrderTime) (time.Time, error) {
+	s := t.String()
+	if s == "" {
+		return time.Time{}, nil
+	}
+
+	secs, err := strconv.ParseInt(strings.TrimPrefix(s, "+"), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: expiretm %q", kraken.ErrInvalidArguments, s)
+	}
+
+	if strings.HasPrefix(s, "+") {
+		return e.now().Add(time.Duration(secs) * time.Second), nil
+	}
+	return time.Unix(secs, 0), nil
+}
+
+// supported returns ErrNotSupported for the parameters that are not simulated.
+func supported(opts kraken.AddOrderOpts) error {
+	var feature string
+
+	switch {
+	case opts.OrderType == kraken.TrailingStop || opts.OrderType == kraken.TrailingStopLimit || opts.OrderType == kraken.SettlePosition:
+		feature = string(opts.OrderType) + " orders"
+	case opts.Leverage != "" && opts.Leverage != "none", opts.ReduceOnly:
+		feature = "margin trading"
+	case opts.CloseOrderType != "":
+		feature = "conditional close orders"
+	case !opts.Starttm.IsZero():
+		feature = "scheduled orders"
+	case opts.OrderFlags.Has(kraken.OrderFlagVolumeInQuote):
+		feature = "volume in quote currency"
+	case strings.HasPrefix(string(opts.Price), "#") || strings.HasPrefix(string(opts.Price2), "#"):
+		feature = "direction dependent prices"
+	default:
+		return nil
+	}
+
+	return fmt.Errorf("%s: %w", feature, ErrNotSupported)
+}
+
+// resolvePrice returns the absolute price, resolving offsets and percentages from the reference
+// price and rounding them to the tick size of the pair.
+func resolvePrice(p kraken.OrderPrice, reference kraken.Decimal, info kraken.AssetPairInfo) (kraken.Decimal, error) {
+	if price, ok := p.Decimal(); ok {
+		return price, nil
+	}
+
+	s := string(p)
+
+	percent := strings.HasSuffix(s, "%")
+	offset, err := kraken.ParseDecimal(strings.TrimPrefix(strings.TrimSuffix(s, "%"), "+"))
+	if err != nil {
+		return "", fmt.Errorf("%w: %q", kraken.ErrInvalidPrice, s)
+	}
+
+	if percent {
+		offset = reference.Mul(offset)
+		if offset, err = offset.Div("100", 18); err != nil {
+			return "", err
+		}
+	}
+
+	return info.RoundPrice(reference.Add(offset)), nil
+}
+
+// usdValue converts an amount of the asset to USD, the currency of the fee tiers, at the mid
+// price of the book of a pair between the asset and USD. It returns false if there is none.
+func (e *Exchange) usdValue(asset kraken.Asset, amount kraken.Decimal) (kraken.Decimal, bool) {
+	if asset == kraken.ZUSD {
+		return amount, true
+	}
+
+	for pair, info := range e.pairs {
+		b, ok := e.books[pair]
+		if !ok {
+			continue
+		}
+		mid, ok := b.Mid()
+		if !ok || mid.Sign() <= 0 {
+			continue
+		}
+
+		base, quote := kraken.Asset(info.Base), kraken.Asset(info.Quote)
+		switch {
+		case base == asset && quote == kraken.ZUSD:
+			return amount.Mul(mid), true
+		case base == kraken.ZUSD && quote == asset:
+			usd, err := amount.Div(mid, 18)
+			return usd, err == nil
+		}
+	}
+
+	return "", false
+}
+
+// feeRate returns the fee rate of the pair for the traded volume, e.g. "0.0026" for 0.26%.
+// Makers are charged the maker schedule if the pair has one.
+func feeRate(info kraken.AssetPairInfo, volume kraken.Decimal, maker bool) kraken.Decimal {
+	schedule := info.Fees
+	if maker && len(info.FeesMaker) > 0 {
+		schedule = info.FeesMaker
+	}
+
+	percent := kraken.Decimal("0")
+	for _, tier := range schedule {
+		if len(tier) < 2 || volume.Cmp(kraken.NewDecimalFromFloat(tier[0])) < 0 {
+			break
+		}
+		percent = kraken.NewDecimalFromFloat(tier[1])
+	}
+
+	return percent.Mul("0.01")
+}
+
+// feeInBase returns true if the fee of the order is charged in the base asset, the default for sells.
+func feeInBase(opts kraken.AddOrderOpts) bool {
+	if opts.OrderFlags.Has(kraken.OrderFlagFeeInBase) {
+		return true
+	}
+	return opts.Type == kraken.Sell && !opts.OrderFlags.Has(kraken.OrderFlagFeeInQuote)
+}
+
+// crosses returns true if an order in the given direction with the limit price can be filled at the price.
+func crosses(direction kraken.OrderDirection, price, limit kraken.Decimal) bool {
+	if direction == kraken.Sell {
+		return price.Cmp(limit) >= 0
+	}
+	return price.Cmp(limit) <= 0
+}
+
+// worst returns the last level an order in the given direction would take.
+func worst(b *kraken.Book, direction kraken.OrderDirection) (kraken.BookLevel, bool) {
+	levels := b.Asks
+	if direction == kraken.Sell {
+		levels = b.Bids
+	}
+	if len(levels) == 0 {
+		return kraken.BookLevel{}, false
+	}
+	return levels[len(levels)-1], true
+}
+
+// info returns the information of the open order as reported by Kraken.
+func info(o *order) kraken.OrderInfo {
+	userref, _ := strconv.ParseInt(o.opts.UserRef, 10, 64)
+
+	price2 := kraken.Decimal("0")
+	if o.opts.OrderType == kraken.StopLossLimit || o.opts.OrderType == kraken.TakeProfitLimit {
+		price2 = o.limit
+	}
+
+	v := kraken.OrderInfo{
+		UserRef:       userref,
+		ClientOrderID: o.opts.ClientOrderID,
+		Status:        kraken.OrderOpen,
+		OpenTime:      float64(o.opened.UnixNano()) / float64(time.Second),
+		Description: kraken.OrderDescription{
+			AssetPair:      o.info.Altname,
+			Leverage:       "none",
+			Order:          describe(o),
+			OrderType:      string(o.opts.OrderType),
+			PrimaryPrice:   string(o.price),
+			SecondaryPrice: string(price2),
+			Type:           string(o.opts.Type),
+		},
+		Volume:         o.opts.Volume,
+		VolumeExecuted: o.executed,
+		Cost:           o.cost,
+		Fee:            o.fee,
+		Price:          "0",
+		StopPrice:      "0",
+		LimitPrice:     "0",
+		OrderFlags:     o.opts.OrderFlags.String(),
+	}
+
+	if !o.expire.IsZero() {
+		v.ExpireTime = float64(o.expire.Unix())
+	}
+	if o.executed.Sign() > 0 {
+		v.Price, _ = o.cost.Div(o.executed, int32(o.info.PairDecimals))
+	}
+
+	return v
+}
+
+// describe returns the description of the order, e.g. "buy 0.5 XBTUSD @ limit 30300.1"
+// or "sell 0.5 XBTUSD @ stop loss 29000.0 -> limit 28900.0".
+func describe(o *order) string {
+	orderType := strings.TrimSuffix(string(o.opts.OrderType), "-limit")
+	d := fmt.Sprintf("%s %s %s @ %s", o.opts.Type, o.opts.Volume, o.info.Altname, strings.ReplaceAll(orderType, "-", " "))
+
+	switch o.opts.OrderType {
+	case kraken.Market:
+	case kraken.StopLossLimit, kraken.TakeProfitLimit:
+		d += fmt.Sprintf(" %s -> limit %s", o.trigger, o.limit)
+	case kraken.Limit:
+		d += " " + string(o.limit)
+	default:
+		d += " " + string(o.trigger)
+	}
+
+	return d
+}
@@ -0,0 +1,496 @@
+package papertrade
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/jferrl/go-kraken"
+)
+
+func assertBalance(t *testing.T, e *Exchange, asset kraken.Asset, balance, hold kraken.Decimal) {
+	t.Helper()
+
+	got, err := e.ExtendedBalance(context.Background())
+	if err != nil {
+		t.Fatalf("Exchange.ExtendedBalance() error = %v", err)
+	}
+	if b := got[asset]; !b.Balance.Equal(balance) || !b.HoldTrade.Equal(hold) {
+		t.Errorf("balance of %s = %s (%s held), want %s (%s held)", asset, b.Balance, b.HoldTrade, balance, hold)
+	}
+}
+
+func TestExchange_AddOrder(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		opts      kraken.AddOrderOpts
+		feeVolume kraken.Decimal
+		want      *kraken.OrderCreation
+		wantErr   error
+		wantXBT   kraken.Decimal
+		wantUSD   kraken.Decimal
+		wantHold  kraken.Decimal
+		wantFills int
+	}{
+		{
+			name: "market buy takes several levels",
+			opts: kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Market, Volume: "1.5"},
+			want: &kraken.OrderCreation{
+				Description: kraken.OrderDescription{Order: "buy 1.5 XBTUSD @ market"},
+				Transaction: []kraken.TransactionID{"OPAPER-000001"},
+			},
+			// 30000 + 0.5 * 30010 = 45005, plus a 0.26% taker fee of 117.013.
+			wantXBT:   "2.5",
+			wantUSD:   "54877.987",
+			wantHold:  "0",
+			wantFills: 2,
+		},
+		{
+			name:      "market buy in a higher fee tier",
+			opts:      kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Market, Volume: "1"},
+			feeVolume: "60000",
+			wantXBT:   "2",
+			wantUSD:   "69928",
+			wantHold:  "0",
+			wantFills: 1,
+		},
+		{
+			name: "market sell charges the fee in base",
+			opts: kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.Market, Volume: "0.5"},
+			want: &kraken.OrderCreation{
+				Description: kraken.OrderDescription{Order: "sell 0.5 XBTUSD @ market"},
+				Transaction: []kraken.TransactionID{"OPAPER-000001"},
+			},
+			wantXBT:   "0.4987",
+			wantUSD:   "114995",
+			wantHold:  "0",
+			wantFills: 1,
+		},
+		{
+			name: "market sell with fee in quote",
+			opts: kraken.AddOrderOpts{
+				Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.Market, Volume: "0.5",
+				OrderFlags: kraken.OrderFlags{kraken.OrderFlagFeeInQuote},
+			},
+			wantXBT:   "0.5",
+			wantUSD:   "114956.013",
+			wantHold:  "0",
+			wantFills: 1,
+		},
+		{
+			name: "crossing limit buy takes up to its price and rests",
+			opts: kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "30005.0", Volume: "2"},
+			want: &kraken.OrderCreation{
+				Description: kraken.OrderDescription{Order: "buy 2 XBTUSD @ limit 30005.0"},
+				Transaction: []kraken.TransactionID{"OPAPER-000001"},
+			},
+			wantXBT:   "2",
+			wantUSD:   "69922",
+			wantHold:  "30083.013",
+			wantFills: 1,
+		},
+		{
+			name:      "immediate or cancel limit buy",
+			opts:      kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "30005.0", Volume: "2", TimeInForce: kraken.ImmediateOrCancel},
+			wantXBT:   "2",
+			wantUSD:   "69922",
+			wantHold:  "0",
+			wantFills: 1,
+		},
+		{
+			name: "post only limit buy that would take is cancelled",
+			opts: kraken.AddOrderOpts{
+				Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "30005.0", Volume: "2",
+				OrderFlags: kraken.OrderFlags{kraken.OrderFlagPost},
+			},
+			wantXBT:  "1",
+			wantUSD:  "100000",
+			wantHold: "0",
+		},
+		{
+			name: "relative limit price",
+			opts: kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: kraken.RelativePercent("-1"), Volume: "1"},
+			want: &kraken.OrderCreation{
+				Description: kraken.OrderDescription{Order: "buy 1 XBTUSD @ limit 29695.1"},
+				Transaction: []kraken.TransactionID{"OPAPER-000001"},
+			},
+			wantXBT:  "1",
+			wantUSD:  "100000",
+			wantHold: "29772.30726",
+		},
+		{
+			name: "stop loss limit description",
+			opts: kraken.AddOrderOpts{
+				Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.StopLossLimit, Price: "29000.0", Price2: kraken.RelativePrice("-10"), Volume: "0.5",
+			},
+			want: &kraken.OrderCreation{
+				Description: kraken.OrderDescription{Order: "sell 0.5 XBTUSD @ stop loss 29000.0 -> limit 28990.0"},
+				Transaction: []kraken.TransactionID{"OPAPER-000001"},
+			},
+			wantXBT:  "1",
+			wantUSD:  "100000",
+			wantHold: "0.5013",
+		},
+		{
+			name: "validate only",
+			opts: kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "29000.0", Volume: "1", Validate: true},
+			want: &kraken.OrderCreation{
+				Description: kraken.OrderDescription{Order: "buy 1 XBTUSD @ limit 29000.0"},
+			},
+			wantXBT:  "1",
+			wantUSD:  "100000",
+			wantHold: "0",
+		},
+		{
+			name:    "insufficient funds",
+			opts:    kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "29000.0", Volume: "4"},
+			wantErr: kraken.ErrInsufficientFunds,
+		},
+		{
+			name:    "invalid price",
+			opts:    kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "29000.05", Volume: "1"},
+			wantErr: kraken.ErrInvalidPrice,
+		},
+		{
+			name:    "scheduled orders",
+			opts:    kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "29000.0", Volume: "1", Starttm: kraken.OrderTimeIn(time.Minute)},
+			wantErr: ErrNotSupported,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _ := testExchange(t)
+			if tt.feeVolume != "" {
+				e.SetFeeVolume(tt.feeVolume)
+			}
+
+			got, err := e.AddOrder(ctx, tt.opts)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Exchange.AddOrder() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Exchange.AddOrder() error = %v", err)
+			}
+			if tt.want != nil && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Exchange.AddOrder() = %+v, want %+v", got, tt.want)
+			}
+
+			base, quote := tt.wantHold, kraken.Decimal("0")
+			if tt.opts.Type == kraken.Buy {
+				base, quote = quote, base
+			}
+			assertBalance(t, e, "XXBT", tt.wantXBT, base)
+			assertBalance(t, e, "ZUSD", tt.wantUSD, quote)
+
+			if got := len(e.Trades()); got != tt.wantFills {
+				t.Errorf("trades = %v, want %v", got, tt.wantFills)
+			}
+		})
+	}
+}
+
+func TestExchange_match(t *testing.T) {
+	ctx := context.Background()
+
+	e, _ := testExchange(t)
+
+	// Resting buy filled as a maker when the asks reach its price.
+	buy, err := e.AddOrder(ctx, kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "29995.0", Volume: "1"})
+	if err != nil {
+		t.Fatalf("Exchange.AddOrder() error = %v", err)
+	}
+
+	if err := e.UpdateBook("XBTUSD", kraken.Sell, 0, kraken.BookLevel{Price: "29993.0", Volume: "0.4"}); err != nil {
+		t.Fatalf("Exchange.UpdateBook() error = %v", err)
+	}
+
+	// 0.4 * 29995 = 11998, plus a 0.16% maker fee of 19.1968.
+	assertBalance(t, e, "XXBT", "1.4", "0")
+	assertBalance(t, e, "ZUSD", "87982.8032", "18043.7922")
+
+	// Stop loss sell triggered once the bids fall to its price.
+	if _, err := e.AddOrder(ctx, kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.StopLoss, Price: "29000.0", Volume: "0.5"}); err != nil {
+		t.Fatalf("Exchange.AddOrder() error = %v", err)
+	}
+
+	if err := e.SetBook("XBTUSD", kraken.NewBook(nil, []kraken.BookLevel{{Price: "28990.0", Volume: "5"}})); err != nil {
+		t.Fatalf("Exchange.SetBook() error = %v", err)
+	}
+
+	// 0.5 * 28990 = 14495, with a 0.26% fee of 0.0013 XBT.
+	assertBalance(t, e, "XXBT", "0.8987", "0")
+	assertBalance(t, e, "ZUSD", "102477.8032", "18043.7922")
+
+	trades := e.Trades()
+	if len(trades) != 2 || !trades[0].Maker || trades[0].Price != "29995.0" || trades[1].Maker || trades[1].Price != "28990.0" {
+		t.Errorf("Exchange.Trades() = %+v, want a maker and a taker fill", trades)
+	}
+
+	got, err := e.CancelOrder(ctx, kraken.CancelOrderOpts{TransactionID: string(buy.Transaction[0])})
+	if err != nil {
+		t.Fatalf("Exchange.CancelOrder() error = %v", err)
+	}
+	if got.Count != 1 {
+		t.Errorf("Exchange.CancelOrder() = %v, want %v", got.Count, 1)
+	}
+	assertBalance(t, e, "ZUSD", "102477.8032", "0")
+
+	if _, err := e.CancelOrder(ctx, kraken.CancelOrderOpts{TransactionID: string(buy.Transaction[0])}); !errors.Is(err, kraken.ErrOrderNotFound) {
+		t.Errorf("Exchange.CancelOrder() error = %v, want %v", err, kraken.ErrOrderNotFound)
+	}
+}
+
+func TestExchange_feeVolume(t *testing.T) {
+	ctx := context.Background()
+
+	assets := kraken.Assets{
+		"XETH": {Altname: "ETH", AssetClass: kraken.Currency, Decimals: 10},
+		"XXBT": {Altname: "XBT", AssetClass: kraken.Currency, Decimals: 10},
+		"ZUSD": {Altname: "USD", AssetClass: kraken.Currency, Decimals: 4},
+	}
+
+	pairs := kraken.AssetPairs{
+		"XETHXXBT": {
+			Altname: "ETHXBT", Base: "XETH", Quote: "XXBT", PairDecimals: 5, LotDecimals: 8,
+			OrderMin: "0.01", Fees: []kraken.FeeTuple{{0, 0.26}}, Status: kraken.Online,
+		},
+		"XXBTZUSD": {
+			Altname: "XBTUSD", Base: "XXBT", Quote: "ZUSD", PairDecimals: 1, LotDecimals: 8,
+			OrderMin: "0.0001", Fees: []kraken.FeeTuple{{0, 0.26}}, Status: kraken.Online,
+		},
+	}
+
+	e := New(assets, pairs, kraken.AccountBalance{"XXBT": "1"})
+
+	ethBook := kraken.NewBook([]kraken.BookLevel{{Price: "0.05000", Volume: "10"}}, nil)
+	if err := e.SetBook("ETHXBT", ethBook); err != nil {
+		t.Fatalf("Exchange.SetBook() error = %v", err)
+	}
+
+	buy := kraken.AddOrderOpts{Pair: "ETHXBT", Type: kraken.Buy, OrderType: kraken.Market, Volume: "2"}
+
+	// Without a XBT/USD book, the cost of the trade cannot be converted to USD.
+	if _, err := e.AddOrder(ctx, buy); err != nil {
+		t.Fatalf("Exchange.AddOrder() error = %v", err)
+	}
+	if !e.feeVolume.Equal("0") {
+		t.Errorf("fee volume = %s, want %s", e.feeVolume, "0")
+	}
+
+	usdBook := kraken.NewBook(
+		[]kraken.BookLevel{{Price: "30000.0", Volume: "1"}},
+		[]kraken.BookLevel{{Price: "29990.0", Volume: "1"}},
+	)
+	if err := e.SetBook("XBTUSD", usdBook); err != nil {
+		t.Fatalf("Exchange.SetBook() error = %v", err)
+	}
+
+	// 2 * 0.05 = 0.1 XBT at the mid price of 29995.
+	if _, err := e.AddOrder(ctx, buy); err != nil {
+		t.Fatalf("Exchange.AddOrder() error = %v", err)
+	}
+	if !e.feeVolume.Equal("2999.5") {
+		t.Errorf("fee volume = %s, want %s", e.feeVolume, "2999.5")
+	}
+}
+
+func TestExchange_CancelOrder(t *testing.T) {
+	tests := []struct {
+		name      string
+		txid      string
+		wantCount int
+		wantErr   error
+		wantOpen  int
+	}{
+		{name: "transaction ID", txid: "OPAPER-000003", wantCount: 1, wantOpen: 2},
+		{name: "user reference", txid: "7", wantCount: 1, wantOpen: 2},
+		{name: "client order ID", txid: "sell-1", wantCount: 1, wantOpen: 2},
+		{name: "empty ID", txid: "", wantErr: kraken.ErrInvalidArguments, wantOpen: 3},
+		{name: "unknown ID", txid: "8", wantErr: kraken.ErrOrderNotFound, wantOpen: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			e, _ := testExchange(t)
+
+			orders := []kraken.AddOrderOpts{
+				{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "29000.0", Volume: "0.1", UserRef: "7"},
+				{Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.Limit, Price: "31000.0", Volume: "0.25", ClientOrderID: "sell-1"},
+				{Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.Limit, Price: "32000.0", Volume: "0.25"},
+			}
+			for _, opts := range orders {
+				if _, err := e.AddOrder(ctx, opts); err != nil {
+					t.Fatalf("Exchange.AddOrder() error = %v", err)
+				}
+			}
+
+			got, err := e.CancelOrder(ctx, kraken.CancelOrderOpts{TransactionID: tt.txid})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Exchange.CancelOrder() error = %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && got.Count != tt.wantCount {
+				t.Errorf("Exchange.CancelOrder() = %v, want %v", got.Count, tt.wantCount)
+			}
+
+			open, err := e.OpenOrders(ctx, kraken.OpenOrdersOpts{})
+			if err != nil {
+				t.Fatalf("Exchange.OpenOrders() error = %v", err)
+			}
+			if len(open.Open) != tt.wantOpen {
+				t.Errorf("Exchange.OpenOrders() = %d orders, want %d", len(open.Open), tt.wantOpen)
+			}
+		})
+	}
+}
+
+func TestExchange_OpenOrders(t *testing.T) {
+	ctx := context.Background()
+
+	e, _ := testExchange(t)
+
+	orders := []kraken.AddOrderOpts{
+		{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "30005.0", Volume: "1.5", UserRef: "7"},
+		{Pair: "XBTUSD", Type: kraken.Sell, OrderType: kraken.Limit, Price: "31000.0", Volume: "0.25", ClientOrderID: "sell-1"},
+	}
+	for _, opts := range orders {
+		if _, err := e.AddOrder(ctx, opts); err != nil {
+			t.Fatalf("Exchange.AddOrder() error = %v", err)
+		}
+	}
+
+	all, err := e.OpenOrders(ctx, kraken.OpenOrdersOpts{})
+	if err != nil {
+		t.Fatalf("Exchange.OpenOrders() error = %v", err)
+	}
+	if len(all.Open) != 2 {
+		t.Errorf("Exchange.OpenOrders() = %v, want 2 orders", all.Open)
+	}
+
+	got, err := e.OpenOrders(ctx, kraken.OpenOrdersOpts{UserRef: "7"})
+	if err != nil {
+		t.Fatalf("Exchange.OpenOrders() error = %v", err)
+	}
+
+	// The buy takes the best ask and rests for the volume left.
+	want := &kraken.OpenOrders{Open: map[kraken.TransactionID]kraken.OrderInfo{
+		"OPAPER-000001": {
+			UserRef:  7,
+			Status:   kraken.OrderOpen,
+			OpenTime: 1704164645,
+			Description: kraken.OrderDescription{
+				AssetPair:      "XBTUSD",
+				Leverage:       "none",
+				Order:          "buy 1.5 XBTUSD @ limit 30005.0",
+				OrderType:      "limit",
+				PrimaryPrice:   "30005.0",
+				SecondaryPrice: "0",
+				Type:           "buy",
+			},
+			Volume:         "1.5",
+			VolumeExecuted: "1",
+			Cost:           "30000.0",
+			Fee:            "78.0000",
+			Price:          "30000.0",
+			StopPrice:      "0",
+			LimitPrice:     "0",
+		},
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Exchange.OpenOrders() = %+v, want %+v", got, want)
+	}
+}
+
+func TestExchange_triggered(t *testing.T) {
+	tests := []struct {
+		name      string
+		orderType kraken.OrderType
+		direction kraken.OrderDirection
+		trigger   kraken.Decimal
+		want      bool
+	}{
+		{name: "sell stop loss below the bid", orderType: kraken.StopLoss, direction: kraken.Sell, trigger: "29000.0"},
+		{name: "sell stop loss at the bid", orderType: kraken.StopLoss, direction: kraken.Sell, trigger: "29990.0", want: true},
+		{name: "buy stop loss above the ask", orderType: kraken.StopLossLimit, direction: kraken.Buy, trigger: "31000.0"},
+		{name: "buy stop loss below the ask", orderType: kraken.StopLossLimit, direction: kraken.Buy, trigger: "29000.0", want: true},
+		{name: "sell take profit above the bid", orderType: kraken.TakeProfit, direction: kraken.Sell, trigger: "31000.0"},
+		{name: "sell take profit below the bid", orderType: kraken.TakeProfit, direction: kraken.Sell, trigger: "29000.0", want: true},
+		{name: "buy take profit below the ask", orderType: kraken.TakeProfitLimit, direction: kraken.Buy, trigger: "29000.0"},
+		{name: "buy take profit at the ask", orderType: kraken.TakeProfitLimit, direction: kraken.Buy, trigger: "30000.0", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, _ := testExchange(t)
+
+			o := &order{
+				opts:    kraken.AddOrderOpts{OrderType: tt.orderType, Type: tt.direction},
+				pair:    "XXBTZUSD",
+				trigger: tt.trigger,
+			}
+			if got := e.triggered(o); got != tt.want {
+				t.Errorf("Exchange.triggered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExchange_expiration(t *testing.T) {
+	ctx := context.Background()
+
+	e, clock := testExchange(t)
+
+	_, err := e.AddOrder(ctx, kraken.AddOrderOpts{
+		Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: "29000.0", Volume: "1",
+		TimeInForce: kraken.GoodTillDate, Expiretm: kraken.OrderTimeIn(time.Minute),
+	})
+	if err != nil {
+		t.Fatalf("Exchange.AddOrder() error = %v", err)
+	}
+	assertBalance(t, e, "ZUSD", "100000", "29075.4")
+
+	clock.Advance(time.Minute)
+
+	if err := e.UpdateBook("XBTUSD", kraken.Buy, 0, kraken.BookLevel{Price: "29990.0", Volume: "2"}); err != nil {
+		t.Fatalf("Exchange.UpdateBook() error = %v", err)
+	}
+	assertBalance(t, e, "ZUSD", "100000", "0")
+}
+
+func TestExchange_CancelAllOrdersAfter(t *testing.T) {
+	ctx := context.Background()
+
+	e, clock := testExchange(t)
+
+	for _, price := range []kraken.OrderPrice{"29000.0", "28000.0"} {
+		if _, err := e.AddOrder(ctx, kraken.AddOrderOpts{Pair: "XBTUSD", Type: kraken.Buy, OrderType: kraken.Limit, Price: price, Volume: "1"}); err != nil {
+			t.Fatalf("Exchange.AddOrder() error = %v", err)
+		}
+	}
+
+	got, err := e.CancelAllOrdersAfter(ctx, kraken.CancelAllOrdersAfterOpts{Timeout: 59500 * time.Millisecond})
+	if err != nil {
+		t.Fatalf("Exchange.CancelAllOrdersAfter() error = %v", err)
+	}
+
+	want := &kraken.TriggeredOrderCancellation{CurrentTime: "2024-01-02T03:04:05Z", TriggerTime: "2024-01-02T03:05:05Z"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Exchange.CancelAllOrdersAfter() = %v, want %v", got, want)
+	}
+
+	clock.Advance(59 * time.Second)
+	assertBalance(t, e, "ZUSD", "100000", "57148.2")
+
+	clock.Advance(time.Second)
+	assertBalance(t, e, "ZUSD", "100000", "0")
+
+	if got, _ := e.CancelAllOrders(ctx); got.Count != 0 {
+		t.Errorf("Exchange.CancelAllOrders() = %v, want no open orders", got.Count)
+	}
+}
@@ -0,0 +1,212 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Tier defines the verification tier of a Kraken account.
+// Rate limits depend on the tier of the account that owns the API key.
+type Tier int
+
+const (
+	// StarterTier has a call counter of 15, decaying by 0.33 per second,
+	// and a trading counter of 60 per pair, decaying by 1 per second.
+	StarterTier Tier = iota
+	// IntermediateTier has a call counter of 20, decaying by 0.5 per second,
+	// and a trading counter of 125 per pair, decaying by 2.34 per second.
+	IntermediateTier
+	// ProTier has a call counter of 20, decaying by 1 per second,
+	// and a trading counter of 180 per pair, decaying by 3.75 per second.
+	ProTier
+)
+
+// RateLimitPolicy defines what the rate limiter does when a call would exceed the limit.
+type RateLimitPolicy int
+
+const (
+	// BlockOnRateLimit waits until the counter has decayed enough to perform the call.
+	BlockOnRateLimit RateLimitPolicy = iota
+	// FailOnRateLimit returns a RateLimitError without performing the call.
+	FailOnRateLimit
+)
+
+// callCounterLimits defines the maximum value and the decay per second
+// of the REST API call counter for each tier.
+// Docs: https://docs.kraken.com/rest/#section/Rate-Limits/REST-API-Rate-Limits
+var callCounterLimits = map[Tier]struct{ max, decay float64 }{
+	StarterTier:      {max: 15, decay: 0.33},
+	IntermediateTier: {max: 20, decay: 0.5},
+	ProTier:          {max: 20, decay: 1},
+}
+
+// endpointCosts defines how much each private endpoint increases the call counter.
+// Endpoints not listed here cost 1. Placing and cancelling orders is rate limited
+// by the matching engine on a separate per-pair counter, see TradingRateLimiter.
+var endpointCosts = map[string]float64{
+	"Ledgers":              2,
+	"QueryLedgers":         2,
+	"TradesHistory":        2,
+	"AddOrder":             0,
+	"AddOrderBatch":        0,
+	"EditOrder":            0,
+	"CancelOrder":          0,
+	"CancelOrderBatch":     0,
+	"CancelAll":            0,
+	"CancelAllOrdersAfter": 0,
+}
+
+func endpointCost(endpoint string) float64 {
+	if cost, ok := endpointCosts[endpoint]; ok {
+		return cost
+	}
+	return 1
+}
+
+// RateLimitError is returned when a call is not performed because it would exceed the rate limit.
+// It matches ErrRateLimitExceeded, or ErrOrderRateLimitExceeded for the trading counter, with errors.Is.
+type RateLimitError struct {
+	Endpoint   string
+	Pair       AssetPair // Pair of the trading counter, empty for the call counter.
+	Counter    float64
+	Limit      float64
+	RetryAfter time.Duration // Time until the call can be performed.
+
+	err error
+}
+
+// Error builds the rate limit error message.
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s: counter %.2f of %.2f, retry after %s", e.err, e.Counter, e.Limit, e.RetryAfter)
+}
+
+// Unwrap returns the Kraken error the call would have produced.
+func (e *RateLimitError) Unwrap() error {
+	return e.err
+}
+
+// RateLimiter models the Kraken REST API call counter of an API key.
+// Every private call increases the counter by the cost of the endpoint, and the counter
+// decays over time depending on the account tier. A RateLimiter is safe for concurrent use,
+// so a single instance should be shared by every goroutine using the same API key.
+type RateLimiter struct {
+	mu      sync.Mutex
+	max     float64
+	decay   float64
+	counter float64
+	updated time.Time
+	policy  RateLimitPolicy
+
+	now func() time.Time
+}
+
+// NewRateLimiter returns a new RateLimiter for the given tier.
+func NewRateLimiter(tier Tier, policy RateLimitPolicy) *RateLimiter {
+	limits, ok := callCounterLimits[tier]
+	if !ok {
+		limits = callCounterLimits[StarterTier]
+	}
+
+	return &RateLimiter{
+		max:    limits.max,
+		decay:  limits.decay,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// Counter returns the current value of the call counter.
+func (l *RateLimiter) Counter() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.update()
+	return l.counter
+}
+
+// Limit returns the maximum value of the call counter.
+func (l *RateLimiter) Limit() float64 {
+	return l.max
+}
+
+// Wait reserves the cost of calling the endpoint. Depending on the policy, it blocks
+// until the call can be performed or returns a RateLimitError.
+func (l *RateLimiter) Wait(ctx context.Context, endpoint string) error {
+	cost := endpointCost(endpoint)
+	if cost == 0 {
+		return nil
+	}
+
+	for {
+		l.mu.Lock()
+		l.update()
+
+		if l.counter+cost <= l.max {
+			l.counter += cost
+			l.mu.Unlock()
+			return nil
+		}
+
+		counter := l.counter
+		retryAfter := time.Duration((counter + cost - l.max) / l.decay * float64(time.Second))
+		l.mu.Unlock()
+
+		if l.policy == FailOnRateLimit {
+			return &RateLimitError{
+				Endpoint:   endpoint,
+				Counter:    counter,
+				Limit:      l.max,
+				RetryAfter: retryAfter,
+				err:        ErrRateLimitExceeded,
+			}
+		}
+
+		if err := sleep(ctx, retryAfter); err != nil {
+			return err
+		}
+	}
+}
+
+// observe synchronizes the counter with the result of a call.
+// If Kraken reports that the rate limit was exceeded, the counter is set to its maximum.
+func (l *RateLimiter) observe(err error) {
+	if !errors.Is(err, ErrRateLimitExceeded) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.update()
+	l.counter = l.max
+}
+
+// update decays the counter since the last update.
+func (l *RateLimiter) update() {
+	now := l.now()
+
+	if !l.updated.IsZero() {
+		l.counter -= now.Sub(l.updated).Seconds() * l.decay
+		if l.counter < 0 {
+			l.counter = 0
+		}
+	}
+
+	l.updated = now
+}
+
+// sleep waits for the given duration or until the context is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,165 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	tests := []struct {
+		name        string
+		tier        Tier
+		calls       []string
+		elapsed     time.Duration
+		endpoint    string
+		wantErr     bool
+		wantCounter float64
+	}{
+		{
+			name:        "under the limit",
+			tier:        StarterTier,
+			calls:       []string{"Balance", "Balance"},
+			endpoint:    "Balance",
+			wantCounter: 3,
+		},
+		{
+			name:        "ledgers cost 2",
+			tier:        StarterTier,
+			calls:       []string{"Ledgers"},
+			endpoint:    "TradesHistory",
+			wantCounter: 4,
+		},
+		{
+			name:        "orders do not increase the counter",
+			tier:        StarterTier,
+			calls:       repeat("Balance", 15),
+			endpoint:    "AddOrder",
+			wantCounter: 15,
+		},
+		{
+			name:        "limit exceeded",
+			tier:        StarterTier,
+			calls:       repeat("Balance", 15),
+			endpoint:    "Balance",
+			wantErr:     true,
+			wantCounter: 15,
+		},
+		{
+			name:        "counter decays",
+			tier:        ProTier,
+			calls:       repeat("Balance", 20),
+			elapsed:     2 * time.Second,
+			endpoint:    "Balance",
+			wantCounter: 19,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+			l := NewRateLimiter(tt.tier, FailOnRateLimit)
+			l.now = clock.now
+
+			for _, c := range tt.calls {
+				if err := l.Wait(context.Background(), c); err != nil {
+					t.Fatalf("RateLimiter.Wait() error = %v", err)
+				}
+			}
+
+			clock.advance(tt.elapsed)
+
+			err := l.Wait(context.Background(), tt.endpoint)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("RateLimiter.Wait() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if tt.wantErr && !errors.Is(err, ErrRateLimitExceeded) {
+				t.Errorf("RateLimiter.Wait() error = %v, want ErrRateLimitExceeded", err)
+			}
+			if got := l.Counter(); got != tt.wantCounter {
+				t.Errorf("RateLimiter.Counter() = %v, want %v", got, tt.wantCounter)
+			}
+		})
+	}
+}
+
+func TestRateLimiter_Wait_blocks(t *testing.T) {
+	l := NewRateLimiter(StarterTier, BlockOnRateLimit)
+	l.decay = 1000
+
+	for i := 0; i < 16; i++ {
+		if err := l.Wait(context.Background(), "Balance"); err != nil {
+			t.Fatalf("RateLimiter.Wait() error = %v", err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	l.decay = 0.0001
+	l.counter = l.max
+
+	if err := l.Wait(ctx, "Balance"); !errors.Is(err, context.Canceled) {
+		t.Errorf("RateLimiter.Wait() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestRateLimiter_observe(t *testing.T) {
+	l := NewRateLimiter(IntermediateTier, FailOnRateLimit)
+	l.now = (&fakeClock{t: time.Unix(1688669448, 0)}).now
+
+	l.observe(errors.New("error"))
+	if got := l.Counter(); got != 0 {
+		t.Errorf("RateLimiter.Counter() = %v, want %v", got, 0)
+	}
+
+	l.observe(newError(http.StatusOK, []string{"EAPI:Rate limit exceeded"}))
+	if got := l.Counter(); got != 20 {
+		t.Errorf("RateLimiter.Counter() = %v, want %v", got, 20)
+	}
+}
+
+func TestClient_WithRateLimiter(t *testing.T) {
+	apiMock := createFakeServer(http.StatusOK, "account_balance.json")
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	l := NewRateLimiter(StarterTier, FailOnRateLimit)
+	l.now = (&fakeClock{t: time.Unix(1688669448, 0)}).now
+	l.counter = 14.5
+
+	c := New(apiMock.Client()).WithRateLimiter(l)
+	c.baseURL = baseURL
+
+	if _, err := c.Market.Time(context.Background()); err != nil {
+		t.Errorf("MarketData.Time() error = %v, public calls must not be rate limited", err)
+	}
+
+	var rateLimitErr *RateLimitError
+	if _, err := c.Account.Balance(context.Background()); !errors.As(err, &rateLimitErr) {
+		t.Errorf("Account.Balance() error = %v, want RateLimitError", err)
+	}
+}
+
+func repeat(s string, n int) []string {
+	r := make([]string, n)
+	for i := range r {
+		r[i] = s
+	}
+	return r
+}
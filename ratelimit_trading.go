@@ -0,0 +1,293 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// tradingCounterLimits defines the threshold and the decay per second
+// of the per-pair trading counter for each tier.
+// Docs: https://docs.kraken.com/rest/#section/Rate-Limits/Matching-Engine-Rate-Limits
+var tradingCounterLimits = map[Tier]struct{ max, decay float64 }{
+	StarterTier:      {max: 60, decay: 1},
+	IntermediateTier: {max: 125, decay: 2.34},
+	ProTier:          {max: 180, decay: 3.75},
+}
+
+// agePenalty defines the penalty applied to an order action performed
+// on an order younger than age.
+type agePenalty struct {
+	age     time.Duration
+	penalty float64
+}
+
+var (
+	cancelPenalties = []agePenalty{
+		{age: 5 * time.Second, penalty: 8},
+		{age: 10 * time.Second, penalty: 6},
+		{age: 15 * time.Second, penalty: 5},
+		{age: 45 * time.Second, penalty: 4},
+		{age: 90 * time.Second, penalty: 2},
+		{age: 300 * time.Second, penalty: 1},
+	}
+	editPenalties = []agePenalty{
+		{age: 5 * time.Second, penalty: 6},
+		{age: 10 * time.Second, penalty: 5},
+		{age: 15 * time.Second, penalty: 4},
+		{age: 45 * time.Second, penalty: 2},
+		{age: 90 * time.Second, penalty: 1},
+	}
+)
+
+func penaltyForAge(penalties []agePenalty, age time.Duration) float64 {
+	for _, p := range penalties {
+		if age < p.age {
+			return p.penalty
+		}
+	}
+	return 0
+}
+
+type trackedOrder struct {
+	pair   AssetPair
+	placed time.Time
+}
+
+type tradingCounter struct {
+	value   float64
+	updated time.Time
+}
+
+// TradingRateLimiter models the matching engine rate limit of an API key.
+// Every pair has its own counter, increased by 1 when an order is placed and by a
+// penalty that depends on the age of the order when it is cancelled or edited.
+// The limiter tracks the age of the orders placed through it, so that the penalty of
+// an action can be projected before it is sent. A TradingRateLimiter is safe for concurrent use.
+type TradingRateLimiter struct {
+	mu       sync.Mutex
+	max      float64
+	decay    float64
+	policy   RateLimitPolicy
+	counters map[AssetPair]*tradingCounter
+	orders   map[TransactionID]trackedOrder
+
+	now func() time.Time
+}
+
+// NewTradingRateLimiter returns a new TradingRateLimiter for the given tier.
+func NewTradingRateLimiter(tier Tier, policy RateLimitPolicy) *TradingRateLimiter {
+	limits, ok := tradingCounterLimits[tier]
+	if !ok {
+		limits = tradingCounterLimits[StarterTier]
+	}
+
+	return &TradingRateLimiter{
+		max:      limits.max,
+		decay:    limits.decay,
+		policy:   policy,
+		counters: map[AssetPair]*tradingCounter{},
+		orders:   map[TransactionID]trackedOrder{},
+		now:      time.Now,
+	}
+}
+
+// Limit returns the threshold of the per-pair trading counter.
+func (l *TradingRateLimiter) Limit() float64 {
+	return l.max
+}
+
+// Counter returns the current value of the trading counter of the pair.
+func (l *TradingRateLimiter) Counter(pair AssetPair) float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.counter(pair).value
+}
+
+// OrderAge returns the age of a tracked order.
+func (l *TradingRateLimiter) OrderAge(txid TransactionID) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	o, ok := l.orders[txid]
+	if !ok {
+		return 0, false
+	}
+	return l.now().Sub(o.placed), true
+}
+
+// CancelPenalty returns the penalty that cancelling the order now would add to the counter.
+// Orders that are not tracked have no penalty.
+func (l *TradingRateLimiter) CancelPenalty(txid TransactionID) float64 {
+	age, ok := l.OrderAge(txid)
+	if !ok {
+		return 0
+	}
+	return penaltyForAge(cancelPenalties, age)
+}
+
+// EditPenalty returns the penalty that editing the order now would add to the counter,
+// including the fixed cost of the edit.
+func (l *TradingRateLimiter) EditPenalty(txid TransactionID) float64 {
+	age, ok := l.OrderAge(txid)
+	if !ok {
+		return 1
+	}
+	return 1 + penaltyForAge(editPenalties, age)
+}
+
+// WaitAddOrder reserves the cost of placing an order on the pair.
+func (l *TradingRateLimiter) WaitAddOrder(ctx context.Context, pair AssetPair) error {
+	return l.wait(ctx, "AddOrder", pair, func() float64 { return 1 })
+}
+
+// WaitCancelOrder reserves the penalty of cancelling a tracked order.
+func (l *TradingRateLimiter) WaitCancelOrder(ctx context.Context, txid TransactionID) error {
+	l.mu.Lock()
+	o, ok := l.orders[txid]
+	l.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return l.wait(ctx, "CancelOrder", o.pair, func() float64 {
+		return penaltyForAge(cancelPenalties, l.now().Sub(o.placed))
+	})
+}
+
+// WaitEditOrder reserves the cost of editing a tracked order.
+func (l *TradingRateLimiter) WaitEditOrder(ctx context.Context, txid TransactionID) error {
+	l.mu.Lock()
+	o, ok := l.orders[txid]
+	l.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+
+	return l.wait(ctx, "EditOrder", o.pair, func() float64 {
+		return 1 + penaltyForAge(editPenalties, l.now().Sub(o.placed))
+	})
+}
+
+// TrackOrders starts tracking the age of orders placed on the pair.
+func (l *TradingRateLimiter) TrackOrders(pair AssetPair, txids ...TransactionID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	for _, txid := range txids {
+		l.orders[txid] = trackedOrder{pair: pair, placed: now}
+	}
+}
+
+// ReplaceOrder tracks the order that replaces an edited one.
+// The age of the new order starts when it is replaced.
+func (l *TradingRateLimiter) ReplaceOrder(old, replacement TransactionID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	o, ok := l.orders[old]
+	if !ok {
+		return
+	}
+
+	delete(l.orders, old)
+	l.orders[replacement] = trackedOrder{pair: o.pair, placed: l.now()}
+}
+
+// ForgetOrders stops tracking orders, e.g. once they are cancelled or filled.
+func (l *TradingRateLimiter) ForgetOrders(txids ...TransactionID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for _, txid := range txids {
+		delete(l.orders, txid)
+	}
+}
+
+// orderPair returns the pair of a tracked order.
+func (l *TradingRateLimiter) orderPair(txid TransactionID) (AssetPair, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	o, ok := l.orders[txid]
+	return o.pair, ok
+}
+
+// forgetAll stops tracking every order.
+func (l *TradingRateLimiter) forgetAll() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.orders = map[TransactionID]trackedOrder{}
+}
+
+func (l *TradingRateLimiter) wait(ctx context.Context, endpoint string, pair AssetPair, cost func() float64) error {
+	for {
+		l.mu.Lock()
+
+		c := l.counter(pair)
+		penalty := cost()
+
+		if c.value+penalty <= l.max {
+			c.value += penalty
+			l.mu.Unlock()
+			return nil
+		}
+
+		counter := c.value
+		retryAfter := time.Duration((counter + penalty - l.max) / l.decay * float64(time.Second))
+		l.mu.Unlock()
+
+		if l.policy == FailOnRateLimit {
+			return &RateLimitError{
+				Endpoint:   endpoint,
+				Pair:       pair,
+				Counter:    counter,
+				Limit:      l.max,
+				RetryAfter: retryAfter,
+				err:        ErrOrderRateLimitExceeded,
+			}
+		}
+
+		if err := sleep(ctx, retryAfter); err != nil {
+			return err
+		}
+	}
+}
+
+// observe sets the counter of the pair to its maximum if
+// Kraken reports that the trading rate limit was exceeded.
+func (l *TradingRateLimiter) observe(pair AssetPair, err error) {
+	if !errors.Is(err, ErrOrderRateLimitExceeded) {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.counter(pair).value = l.max
+}
+
+// counter returns the decayed counter of the pair. It must be called with the lock held.
+func (l *TradingRateLimiter) counter(pair AssetPair) *tradingCounter {
+	now := l.now()
+
+	c, ok := l.counters[pair]
+	if !ok {
+		c = &tradingCounter{updated: now}
+		l.counters[pair] = c
+	}
+
+	c.value -= now.Sub(c.updated).Seconds() * l.decay
+	if c.value < 0 {
+		c.value = 0
+	}
+	c.updated = now
+
+	return c
+}
@@ -0,0 +1,219 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestTradingRateLimiter_CancelPenalty(t *testing.T) {
+	tests := []struct {
+		name        string
+		age         time.Duration
+		tracked     bool
+		wantCancel  float64
+		wantEdit    float64
+		wantTracked bool
+	}{
+		{name: "untracked order", wantCancel: 0, wantEdit: 1},
+		{name: "younger than 5s", tracked: true, age: 2 * time.Second, wantCancel: 8, wantEdit: 7},
+		{name: "younger than 15s", tracked: true, age: 12 * time.Second, wantCancel: 5, wantEdit: 5},
+		{name: "younger than 90s", tracked: true, age: time.Minute, wantCancel: 2, wantEdit: 2},
+		{name: "younger than 300s", tracked: true, age: 2 * time.Minute, wantCancel: 1, wantEdit: 1},
+		{name: "older than 300s", tracked: true, age: 10 * time.Minute, wantCancel: 0, wantEdit: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+			l := NewTradingRateLimiter(StarterTier, FailOnRateLimit)
+			l.now = clock.now
+
+			if tt.tracked {
+				l.TrackOrders(XXBTZUSD, "OUF4EM-FRGI2-MQMWZD")
+			}
+
+			clock.advance(tt.age)
+
+			if got := l.CancelPenalty("OUF4EM-FRGI2-MQMWZD"); got != tt.wantCancel {
+				t.Errorf("TradingRateLimiter.CancelPenalty() = %v, want %v", got, tt.wantCancel)
+			}
+			if got := l.EditPenalty("OUF4EM-FRGI2-MQMWZD"); got != tt.wantEdit {
+				t.Errorf("TradingRateLimiter.EditPenalty() = %v, want %v", got, tt.wantEdit)
+			}
+		})
+	}
+}
+
+func TestTradingRateLimiter_Wait(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+	l := NewTradingRateLimiter(StarterTier, FailOnRateLimit)
+	l.now = clock.now
+
+	ctx := context.Background()
+
+	for i := 0; i < 55; i++ {
+		if err := l.WaitAddOrder(ctx, XXBTZUSD); err != nil {
+			t.Fatalf("TradingRateLimiter.WaitAddOrder() error = %v", err)
+		}
+	}
+	l.TrackOrders(XXBTZUSD, "OUF4EM-FRGI2-MQMWZD")
+
+	if got := l.Counter(XXBTZUSD); got != 55 {
+		t.Errorf("TradingRateLimiter.Counter() = %v, want %v", got, 55)
+	}
+
+	if err := l.WaitAddOrder(ctx, XETHZUSD); err != nil {
+		t.Errorf("TradingRateLimiter.WaitAddOrder() error = %v, counters are per pair", err)
+	}
+
+	err := l.WaitCancelOrder(ctx, "OUF4EM-FRGI2-MQMWZD")
+
+	var rateLimitErr *RateLimitError
+	if !errors.As(err, &rateLimitErr) || !errors.Is(err, ErrOrderRateLimitExceeded) {
+		t.Fatalf("TradingRateLimiter.WaitCancelOrder() error = %v, want RateLimitError", err)
+	}
+	if rateLimitErr.Pair != XXBTZUSD || rateLimitErr.RetryAfter != 3*time.Second {
+		t.Errorf("RateLimitError = %+v, want pair %v and retry after %v", rateLimitErr, XXBTZUSD, 3*time.Second)
+	}
+
+	clock.advance(5 * time.Second)
+
+	if err := l.WaitCancelOrder(ctx, "OUF4EM-FRGI2-MQMWZD"); err != nil {
+		t.Errorf("TradingRateLimiter.WaitCancelOrder() error = %v", err)
+	}
+	if got := l.Counter(XXBTZUSD); got != 56 {
+		t.Errorf("TradingRateLimiter.Counter() = %v, want %v", got, 56)
+	}
+}
+
+func TestTradingRateLimiter_ReplaceOrder(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+	l := NewTradingRateLimiter(ProTier, FailOnRateLimit)
+	l.now = clock.now
+
+	l.TrackOrders(XXBTZUSD, "OLD")
+	clock.advance(time.Hour)
+	l.ReplaceOrder("OLD", "NEW")
+
+	if _, ok := l.OrderAge("OLD"); ok {
+		t.Errorf("TradingRateLimiter.OrderAge() replaced order is still tracked")
+	}
+	if age, ok := l.OrderAge("NEW"); !ok || age != 0 {
+		t.Errorf("TradingRateLimiter.OrderAge() = %v, %v, want %v, %v", age, ok, 0, true)
+	}
+
+	l.ForgetOrders("NEW")
+	if _, ok := l.OrderAge("NEW"); ok {
+		t.Errorf("TradingRateLimiter.OrderAge() forgotten order is still tracked")
+	}
+}
+
+func TestClient_WithTradingRateLimiter(t *testing.T) {
+	ctx := context.Background()
+
+	clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+	l := NewTradingRateLimiter(StarterTier, FailOnRateLimit)
+	l.now = clock.now
+
+	addOrderMock := createFakeServer(http.StatusOK, "add_order.json")
+	baseURL, _ := url.Parse(addOrderMock.URL + "/")
+
+	c := New(addOrderMock.Client()).WithTradingRateLimiter(l)
+	c.baseURL = baseURL
+
+	if _, err := c.Trading.AddOrder(ctx, AddOrderOpts{Pair: "XXBTZUSD", Validate: true}); err != nil {
+		t.Fatalf("Trading.AddOrder() error = %v", err)
+	}
+	if got := l.Counter(XXBTZUSD); got != 0 {
+		t.Errorf("TradingRateLimiter.Counter() = %v, validated orders must not be counted", got)
+	}
+
+	if _, err := c.Trading.AddOrder(ctx, AddOrderOpts{Pair: "XXBTZUSD"}); err != nil {
+		t.Fatalf("Trading.AddOrder() error = %v", err)
+	}
+	if got := l.CancelPenalty("OUF4EM-FRGI2-MQMWZD"); got != 8 {
+		t.Errorf("TradingRateLimiter.CancelPenalty() = %v, want %v", got, 8)
+	}
+
+	editOrderMock := createFakeServer(http.StatusOK, "edit_order.json")
+	c.baseURL, _ = url.Parse(editOrderMock.URL + "/")
+
+	if _, err := c.Trading.EditOrder(ctx, EditOrderOpts{TransactionID: "OUF4EM-FRGI2-MQMWZD", Pair: "XXBTZUSD", Price: "19500.0"}); err != nil {
+		t.Fatalf("Trading.EditOrder() error = %v", err)
+	}
+	if got := l.Counter(XXBTZUSD); got != 8 {
+		t.Errorf("TradingRateLimiter.Counter() = %v, want %v", got, 8)
+	}
+	if _, ok := l.OrderAge("OUF4EM-FRGI2-MQMWZD"); ok {
+		t.Errorf("TradingRateLimiter.OrderAge() edited order is still tracked")
+	}
+
+	rateLimitMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"error":["EOrder:Rate limit exceeded"]}`)
+	}))
+	c.baseURL, _ = url.Parse(rateLimitMock.URL + "/")
+
+	if _, err := c.Trading.CancelOrder(ctx, CancelOrderOpts{TransactionID: "OFVXHJ-KPQ3B-VS7ELA"}); !errors.Is(err, ErrOrderRateLimitExceeded) {
+		t.Fatalf("Trading.CancelOrder() error = %v, want %v", err, ErrOrderRateLimitExceeded)
+	}
+	if got := l.Counter(XXBTZUSD); got != l.Limit() {
+		t.Errorf("TradingRateLimiter.Counter() = %v, want %v", got, l.Limit())
+	}
+	if _, ok := l.OrderAge("OFVXHJ-KPQ3B-VS7ELA"); !ok {
+		t.Errorf("TradingRateLimiter.OrderAge() order that failed to be cancelled is not tracked")
+	}
+
+	clock.advance(time.Minute)
+
+	cancelOrderMock := createFakeServer(http.StatusOK, "cancel_order.json")
+	c.baseURL, _ = url.Parse(cancelOrderMock.URL + "/")
+
+	if _, err := c.Trading.CancelOrder(ctx, CancelOrderOpts{TransactionID: "OFVXHJ-KPQ3B-VS7ELA"}); err != nil {
+		t.Fatalf("Trading.CancelOrder() error = %v", err)
+	}
+	if got := l.Counter(XXBTZUSD); got != 2 {
+		t.Errorf("TradingRateLimiter.Counter() = %v, want %v", got, 2)
+	}
+	if _, ok := l.OrderAge("OFVXHJ-KPQ3B-VS7ELA"); ok {
+		t.Errorf("TradingRateLimiter.OrderAge() cancelled order is still tracked")
+	}
+}
+
+func TestClient_WithTradingRateLimiter_retries(t *testing.T) {
+	clock := &fakeClock{t: time.Unix(1688669448, 0)}
+
+	l := NewTradingRateLimiter(StarterTier, FailOnRateLimit)
+	l.now = clock.now
+
+	var nonces []string
+
+	apiMock := newFlakyServer(2, "add_order.json", &nonces)
+	defer apiMock.Close()
+
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	c := New(apiMock.Client()).
+		WithTradingRateLimiter(l).
+		WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond})
+	c.baseURL = baseURL
+
+	if _, err := c.Trading.AddOrder(context.Background(), AddOrderOpts{Pair: "XXBTZUSD", ClientOrderID: "order-1"}); err != nil {
+		t.Fatalf("Trading.AddOrder() error = %v", err)
+	}
+	if len(nonces) != 3 {
+		t.Fatalf("Trading.AddOrder() sent %d requests, want %d", len(nonces), 3)
+	}
+	if got := l.Counter(XXBTZUSD); got != 3 {
+		t.Errorf("TradingRateLimiter.Counter() = %v, want every attempt counted", got)
+	}
+}
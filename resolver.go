@@ -0,0 +1,197 @@
+package kraken
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// assetAliases maps common asset names to the names used by Kraken.
+var assetAliases = map[string]string{
+	"BTC":  "XBT",
+	"DOGE": "XDG",
+}
+
+// pairSeparators are the separators found between the base and the quote of pair names,
+// e.g. "XBT/USD" or "BTC-USD".
+const pairSeparators = "/-_"
+
+// Resolver maps the different names Kraken uses for the same asset or asset pair,
+// e.g. XXBT, XBT and BTC or XXBTZUSD, XBTUSD, XBT/USD and BTC/USD, to their canonical
+// names: the keys returned by the Assets and AssetPairs endpoints.
+type Resolver struct {
+	assets map[string]Asset
+	pairs  map[string]AssetPair
+	quotes map[Asset]map[Asset]AssetPair
+	info   AssetPairs
+}
+
+// NewResolver returns a new Resolver built from the assets and the asset pairs available on Kraken.
+func NewResolver(assets Assets, pairs AssetPairs) *Resolver {
+	r := &Resolver{
+		assets: map[string]Asset{},
+		pairs:  map[string]AssetPair{},
+		quotes: map[Asset]map[Asset]AssetPair{},
+		info:   pairs,
+	}
+
+	for asset, info := range assets {
+		r.assets[normalizeName(string(asset))] = asset
+		if info.Altname != "" {
+			r.assets[normalizeName(info.Altname)] = asset
+		}
+	}
+
+	// Sorted so that conflicting names always resolve to the same pair.
+	names := make([]AssetPair, 0, len(pairs))
+	for pair := range pairs {
+		names = append(names, pair)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+
+	for _, pair := range names {
+		info := pairs[pair]
+
+		for _, name := range []string{string(pair), info.Altname, info.WSName} {
+			if n := normalizeName(name); n != "" {
+				if _, ok := r.pairs[n]; !ok {
+					r.pairs[n] = pair
+				}
+			}
+		}
+
+		base, quote := Asset(info.Base), Asset(info.Quote)
+		if r.quotes[base] == nil {
+			r.quotes[base] = map[Asset]AssetPair{}
+		}
+		if _, ok := r.quotes[base][quote]; !ok {
+			r.quotes[base][quote] = pair
+		}
+	}
+
+	return r
+}
+
+// Resolver builds a Resolver from all the assets and asset pairs available on Kraken.
+func (m *MarketData) Resolver(ctx context.Context) (*Resolver, error) {
+	assets, err := m.Assets(ctx, AssetsOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	pairs, err := m.TradableAssetPairs(ctx, TradableAssetPairsOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResolver(assets, pairs), nil
+}
+
+// Asset returns the canonical name of an asset given any of its names, e.g. XXBT for BTC.
+func (r *Resolver) Asset(name string) (Asset, bool) {
+	n := normalizeName(name)
+	if a, ok := r.assets[n]; ok {
+		return a, true
+	}
+
+	if alias, ok := assetAliases[n]; ok {
+		a, ok := r.assets[alias]
+		return a, ok
+	}
+
+	return "", false
+}
+
+// Pair returns the canonical name of an asset pair given any of its names,
+// e.g. XXBTZUSD for XBTUSD, XBT/USD, BTC/USD or BTCUSD.
+func (r *Resolver) Pair(name string) (AssetPair, bool) {
+	n := normalizeName(name)
+	if p, ok := r.pairs[n]; ok {
+		return p, true
+	}
+
+	if i := strings.IndexAny(n, pairSeparators); i >= 0 {
+		return r.pairOf(n[:i], n[i+1:])
+	}
+
+	// Concatenated names with aliases, e.g. BTCUSD.
+	for i := 1; i < len(n); i++ {
+		if p, ok := r.pairOf(n[:i], n[i:]); ok {
+			return p, true
+		}
+	}
+
+	return "", false
+}
+
+// Split returns the canonical base and quote assets of an asset pair given any of its names.
+func (r *Resolver) Split(name string) (base, quote Asset, ok bool) {
+	p, ok := r.Pair(name)
+	if !ok {
+		return "", "", false
+	}
+
+	info := r.info[p]
+	return Asset(info.Base), Asset(info.Quote), true
+}
+
+// Info returns the information about an asset pair given any of its names.
+func (r *Resolver) Info(name string) (AssetPairInfo, bool) {
+	p, ok := r.Pair(name)
+	if !ok {
+		return AssetPairInfo{}, false
+	}
+	return r.info[p], true
+}
+
+func (r *Resolver) pairOf(base, quote string) (AssetPair, bool) {
+	b, ok := r.Asset(base)
+	if !ok {
+		return "", false
+	}
+
+	q, ok := r.Asset(quote)
+	if !ok {
+		return "", false
+	}
+
+	p, ok := r.quotes[b][q]
+	return p, ok
+}
+
+func normalizeName(name string) string {
+	return strings.ToUpper(strings.TrimSpace(name))
+}
+
+// WithResolver sets the Resolver used to find the asset pairs in the responses of Kraken,
+// which are keyed by the canonical name of the pair even when it's requested by another name.
+func (c *Client) WithResolver(r *Resolver) *Client {
+	c.resolver = r
+	return c
+}
+
+// responsePair returns the key of the requested pair among the keys of a response.
+// Without a Resolver, a response with a single pair is assumed to hold the requested one.
+func (c *Client) responsePair(requested AssetPair, keys []string) string {
+	for _, k := range keys {
+		if k == string(requested) {
+			return k
+		}
+	}
+
+	if c.resolver != nil {
+		if want, ok := c.resolver.Pair(string(requested)); ok {
+			for _, k := range keys {
+				if p, ok := c.resolver.Pair(k); ok && p == want {
+					return k
+				}
+			}
+		}
+	}
+
+	if len(keys) == 1 {
+		return keys[0]
+	}
+
+	return string(requested)
+}
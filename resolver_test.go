@@ -0,0 +1,162 @@
+package kraken
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+)
+
+func loadResult(t *testing.T, file string, v any) {
+	t.Helper()
+
+	b, err := os.ReadFile("testdata/" + file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := Response{Result: v}
+	if err := json.Unmarshal(b, &res); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testResolver(t *testing.T) *Resolver {
+	t.Helper()
+
+	var (
+		assets Assets
+		pairs  AssetPairs
+	)
+	loadResult(t, "asset_info.json", &assets)
+	loadResult(t, "asset_pairs.json", &pairs)
+
+	return NewResolver(assets, pairs)
+}
+
+func TestResolver_Asset(t *testing.T) {
+	r := testResolver(t)
+
+	tests := []struct {
+		name   string
+		want   Asset
+		wantOk bool
+	}{
+		{name: "XXBT", want: XXBT, wantOk: true},
+		{name: "XBT", want: XXBT, wantOk: true},
+		{name: "BTC", want: XXBT, wantOk: true},
+		{name: "btc", want: XXBT, wantOk: true},
+		{name: "DOGE", want: XXDG, wantOk: true},
+		{name: "USD", want: ZUSD, wantOk: true},
+		{name: "USDT", want: USDT, wantOk: true},
+		{name: "UNKNOWN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := r.Asset(tt.name)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Resolver.Asset() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestResolver_Pair(t *testing.T) {
+	r := testResolver(t)
+
+	tests := []struct {
+		name   string
+		want   AssetPair
+		wantOk bool
+	}{
+		{name: "XXBTZUSD", want: XXBTZUSD, wantOk: true},
+		{name: "XBTUSD", want: XXBTZUSD, wantOk: true},
+		{name: "XBT/USD", want: XXBTZUSD, wantOk: true},
+		{name: "BTC/USD", want: XXBTZUSD, wantOk: true},
+		{name: "btc-usd", want: XXBTZUSD, wantOk: true},
+		{name: "BTCUSD", want: XXBTZUSD, wantOk: true},
+		{name: "BTCUSDT", want: XBTUSDT, wantOk: true},
+		{name: "ETH/BTC", want: XETHXXBT, wantOk: true},
+		{name: "DOGE/USD", want: XDGUSD, wantOk: true},
+		{name: "BTC/UNKNOWN"},
+		{name: "UNKNOWN"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := r.Pair(tt.name)
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Resolver.Pair() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestResolver_Split(t *testing.T) {
+	r := testResolver(t)
+
+	base, quote, ok := r.Split("BTC/USD")
+	if base != XXBT || quote != ZUSD || !ok {
+		t.Errorf("Resolver.Split() = %v, %v, %v, want %v, %v, true", base, quote, ok, XXBT, ZUSD)
+	}
+
+	if _, _, ok := r.Split("UNKNOWN"); ok {
+		t.Errorf("Resolver.Split() ok = true, want false")
+	}
+
+	if info, ok := r.Info("XBT/USD"); !ok || info.Altname != "XBTUSD" {
+		t.Errorf("Resolver.Info() = %v, %v, want altname XBTUSD", info.Altname, ok)
+	}
+}
+
+func TestMarketData_OrderBook_resolver(t *testing.T) {
+	tests := []struct {
+		name     string
+		res      string
+		resolver bool
+		wantAsks int
+	}{
+		{
+			name:     "single pair response",
+			res:      `{"error":[],"result":{"XXBTZUSD":{"asks":[["30384.10000","2.059",1688671659]],"bids":[]}}}`,
+			wantAsks: 1,
+		},
+		{
+			name:     "pair requested by alt name",
+			res:      `{"error":[],"result":{"XETHZUSD":{"asks":[],"bids":[]},"XXBTZUSD":{"asks":[["30384.10000","2.059",1688671659]],"bids":[]}}}`,
+			resolver: true,
+			wantAsks: 1,
+		},
+		{
+			name:     "ambiguous response without resolver",
+			res:      `{"error":[],"result":{"XETHZUSD":{"asks":[],"bids":[]},"XXBTZUSD":{"asks":[["30384.10000","2.059",1688671659]],"bids":[]}}}`,
+			wantAsks: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(tt.res))
+			}))
+			defer apiMock.Close()
+
+			baseURL, _ := url.Parse(apiMock.URL + "/")
+
+			c := New(apiMock.Client())
+			c.baseURL = baseURL
+			if tt.resolver {
+				c.WithResolver(testResolver(t))
+			}
+
+			got, err := c.Market.OrderBook(context.Background(), OrderBookOpts{Pair: "BTC/USD"})
+			if err != nil {
+				t.Fatalf("MarketData.OrderBook() error = %v", err)
+			}
+			if len(got.Asks) != tt.wantAsks {
+				t.Errorf("MarketData.OrderBook() = %v asks, want %v", len(got.Asks), tt.wantAsks)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy defines how requests that failed with a transient error are retried.
+// The zero value disables retries.
+type RetryPolicy struct {
+	MaxAttempts    int           // Maximum number of attempts, including the first one.
+	InitialBackoff time.Duration // Wait before the first retry.
+	MaxBackoff     time.Duration // Maximum wait between attempts.
+	Multiplier     float64       // Factor applied to the backoff after each attempt.
+	Jitter         float64       // Fraction of the backoff randomly added or removed, between 0 and 1.
+}
+
+// DefaultRetryPolicy returns a policy retrying up to 3 times with an exponential
+// backoff starting at 500ms.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.2,
+	}
+}
+
+// readOnlyEndpoints defines the private endpoints that can be safely retried.
+var readOnlyEndpoints = map[string]bool{
+	"Balance":                 true,
+	"BalanceEx":               true,
+	"TradeBalance":            true,
+	"OpenOrders":              true,
+	"ClosedOrders":            true,
+	"QueryOrders":             true,
+	"TradesHistory":           true,
+	"QueryTrades":             true,
+	"OpenPositions":           true,
+	"Ledgers":                 true,
+	"QueryLedgers":            true,
+	"TradeVolume":             true,
+	"GetWebSocketsToken":      true,
+	"Earn/Strategies":         true,
+	"Earn/Allocations":        true,
+	"Earn/AllocationStatus":   true,
+	"Earn/DeallocationStatus": true,
+}
+
+// idempotent returns true if the request can be sent again without side effects.
+func (i requestInfo) idempotent() bool {
+	if !i.private {
+		return true
+	}
+
+	if i.endpoint == "AddOrder" {
+		return i.body.value("cl_ord_id") != ""
+	}
+
+	return readOnlyEndpoints[i.endpoint]
+}
+
+// shouldRetry returns true if the request should be attempted again after the given attempt failed with err.
+func (p RetryPolicy) shouldRetry(attempt int, info requestInfo, err error) bool {
+	return attempt < p.MaxAttempts && info.idempotent() && isTransient(err)
+}
+
+// backoff returns the time to wait after the given attempt.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	multiplier := p.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+
+	d := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 && d > float64(p.MaxBackoff) {
+		d = float64(p.MaxBackoff)
+	}
+
+	if p.Jitter > 0 {
+		d += d * p.Jitter * (2*rand.Float64() - 1) //nolint:gosec // Jitter is not security-sensitive.
+	}
+
+	return time.Duration(d)
+}
+
+// isTransient returns true if the error is likely to go away by retrying the request.
+func isTransient(err error) bool {
+	if errors.Is(err, ErrServiceUnavailable) ||
+		errors.Is(err, ErrServiceBusy) ||
+		errors.Is(err, ErrTemporaryLockout) {
+		return true
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError || httpErr.StatusCode == http.StatusTooManyRequests
+	}
+
+	var transportErr *TransportError
+	if !errors.As(err, &transportErr) {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	if errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
@@ -0,0 +1,214 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func Test_isTransient(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "service unavailable", err: newError(http.StatusOK, []string{"EService:Unavailable"}), want: true},
+		{name: "service busy", err: newError(http.StatusOK, []string{"EService:Busy"}), want: true},
+		{name: "temporary lockout", err: newError(http.StatusOK, []string{"EGeneral:Temporary lockout"}), want: true},
+		{name: "insufficient funds", err: newError(http.StatusOK, []string{"EOrder:Insufficient funds"}), want: false},
+		{name: "bad gateway", err: &HTTPError{StatusCode: http.StatusBadGateway}, want: true},
+		{name: "too many requests", err: &HTTPError{StatusCode: http.StatusTooManyRequests}, want: true},
+		{name: "not found", err: &HTTPError{StatusCode: http.StatusNotFound}, want: false},
+		{name: "connection reset", err: &TransportError{Err: &url.Error{Err: syscall.ECONNRESET}}, want: true},
+		{name: "unexpected eof", err: &TransportError{Err: io.ErrUnexpectedEOF}, want: true},
+		{name: "context canceled", err: &TransportError{Err: &url.Error{Err: context.Canceled}}, want: false},
+		{name: "decode error", err: &DecodeError{Err: ErrEmptyResponse}, want: false},
+		{name: "other error", err: errors.New("error"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isTransient(tt.err); got != tt.want {
+				t.Errorf("isTransient() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_requestInfo_idempotent(t *testing.T) {
+	tests := []struct {
+		name string
+		info requestInfo
+		want bool
+	}{
+		{name: "public", info: requestInfo{endpoint: "Time"}, want: true},
+		{name: "read only", info: requestInfo{endpoint: "Balance", private: true, body: newFormURLEncodedBody(nil)}, want: true},
+		{name: "read only json", info: requestInfo{endpoint: "Earn/Strategies", private: true, body: jsonBody{jsonMessage{}}}, want: true},
+		{name: "add order", info: requestInfo{endpoint: "AddOrder", private: true, body: newFormURLEncodedBody(nil)}, want: false},
+		{
+			name: "add order with client order id",
+			info: requestInfo{endpoint: "AddOrder", private: true, body: newFormURLEncodedBody(url.Values{"cl_ord_id": []string{"id"}})},
+			want: true,
+		},
+		{name: "cancel order", info: requestInfo{endpoint: "CancelOrder", private: true, body: newFormURLEncodedBody(nil)}, want: false},
+		{name: "allocate", info: requestInfo{endpoint: "Earn/Allocate", private: true, body: jsonBody{jsonMessage{}}}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.info.idempotent(); got != tt.want {
+				t.Errorf("requestInfo.idempotent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	p := RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: 100 * time.Millisecond,
+		MaxBackoff:     time.Second,
+		Multiplier:     4,
+	}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+	}{
+		{attempt: 1, want: 100 * time.Millisecond},
+		{attempt: 2, want: 400 * time.Millisecond},
+		{attempt: 3, want: time.Second},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("attempt %d", tt.attempt), func(t *testing.T) {
+			if got := p.backoff(tt.attempt); got != tt.want {
+				t.Errorf("RetryPolicy.backoff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	p.Jitter = 0.5
+	if got := p.backoff(1); got < 50*time.Millisecond || got > 150*time.Millisecond {
+		t.Errorf("RetryPolicy.backoff() = %v, want between %v and %v", got, 50*time.Millisecond, 150*time.Millisecond)
+	}
+}
+
+// newFlakyServer returns a server that replies with a 503 to the first failures requests.
+func newFlakyServer(failures int, res string, nonces *[]string) *httptest.Server {
+	var calls int
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		*nonces = append(*nonces, r.PostForm.Get("nonce")+r.Header.Get("API-Sign"))
+
+		calls++
+		if calls <= failures {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		http.ServeFile(w, r, "testdata/"+res)
+	}))
+}
+
+func TestClient_WithRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	tests := []struct {
+		name      string
+		failures  int
+		call      func(c *Client) error
+		res       string
+		wantCalls int
+		wantErr   bool
+	}{
+		{
+			name:     "public call is retried",
+			failures: 2,
+			res:      "server_time.json",
+			call: func(c *Client) error {
+				_, err := c.Market.Time(context.Background())
+				return err
+			},
+			wantCalls: 3,
+		},
+		{
+			name:     "retries are exhausted",
+			failures: 3,
+			res:      "server_time.json",
+			call: func(c *Client) error {
+				_, err := c.Market.Time(context.Background())
+				return err
+			},
+			wantCalls: 3,
+			wantErr:   true,
+		},
+		{
+			name:     "read only private call is retried",
+			failures: 1,
+			res:      "account_balance.json",
+			call: func(c *Client) error {
+				_, err := c.Account.Balance(context.Background())
+				return err
+			},
+			wantCalls: 2,
+		},
+		{
+			name:     "add order without client order id is not retried",
+			failures: 1,
+			res:      "add_order.json",
+			call: func(c *Client) error {
+				_, err := c.Trading.AddOrder(context.Background(), AddOrderOpts{Pair: "XXBTZUSD"})
+				return err
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:     "add order with client order id is retried",
+			failures: 1,
+			res:      "add_order.json",
+			call: func(c *Client) error {
+				_, err := c.Trading.AddOrder(context.Background(), AddOrderOpts{Pair: "XXBTZUSD", ClientOrderID: "order-1"})
+				return err
+			},
+			wantCalls: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nonces []string
+
+			apiMock := newFlakyServer(tt.failures, tt.res, &nonces)
+			defer apiMock.Close()
+
+			baseURL, _ := url.Parse(apiMock.URL + "/")
+
+			c := New(apiMock.Client()).
+				WithAuth(Secrets{Key: "key", Secret: "kQH5HW/8p1uGOVjbgWA7FunAmGO8lsSUXNsu3eow76sz84Q18fWxnyRzBHCd3pd5nE9qa99HAZtuZuj6F1huXg=="}).
+				WithRetryPolicy(policy)
+			c.baseURL = baseURL
+
+			err := tt.call(c)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("call error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(nonces) != tt.wantCalls {
+				t.Errorf("calls = %v, want %v", len(nonces), tt.wantCalls)
+			}
+
+			seen := map[string]bool{}
+			for _, n := range nonces {
+				if n != "" && seen[n] {
+					t.Errorf("nonce and signature %v sent twice", n)
+				}
+				seen[n] = true
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package kraken
+
+import "context"
+
+// MarketDataService is the set of public market data methods implemented by MarketData.
+// It allows strategies to run against other implementations, e.g. the papertrade package.
+type MarketDataService interface {
+	Time(ctx context.Context) (*ServerTime, error)
+	SystemStatus(ctx context.Context) (*SystemStatus, error)
+	Assets(ctx context.Context, opts AssetsOpts) (Assets, error)
+	TradableAssetPairs(ctx context.Context, opts TradableAssetPairsOpts) (AssetPairs, error)
+	TickerInformation(ctx context.Context, opts TickerInformationOpts) (Tickers, error)
+	OHCLData(ctx context.Context, opts OHCLDataOpts) (*OHCL, error)
+	OrderBook(ctx context.Context, opts OrderBookOpts) (*OrderBook, error)
+}
+
+// AccountService is the set of account data methods implemented by Account.
+type AccountService interface {
+	Balance(ctx context.Context) (AccountBalance, error)
+	ExtendedBalance(ctx context.Context) (AccountExtendedBalance, error)
+}
+
+// TradingService is the set of trading methods implemented by Trading.
+type TradingService interface {
+	AddOrder(ctx context.Context, opts AddOrderOpts) (*OrderCreation, error)
+	OpenOrders(ctx context.Context, opts OpenOrdersOpts) (*OpenOrders, error)
+	CancelOrder(ctx context.Context, opts CancelOrderOpts) (*OrderCancelation, error)
+	CancelAllOrders(ctx context.Context) (*OrderCancelation, error)
+	CancelAllOrdersAfter(ctx context.Context, opts CancelAllOrdersAfterOpts) (*TriggeredOrderCancellation, error)
+}
+
+var (
+	_ MarketDataService = (*MarketData)(nil)
+	_ AccountService    = (*Account)(nil)
+	_ TradingService    = (*Trading)(nil)
+)
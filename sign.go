@@ -1,15 +1,37 @@
 package kraken
 
 import (
+	"context"
 	"crypto/hmac"
 	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/base64"
+	"fmt"
 )
 
 const nonceKey = "nonce"
 
+// RequestSigner signs private requests.
+// It allows signatures to be produced outside of the process memory,
+// e.g. by a remote signing service or a hardware security module.
+type RequestSigner interface {
+	// SignRequest returns the value of the "API-Sign" header for a request
+	// to the URI path with the given nonce and encoded body.
+	SignRequest(ctx context.Context, path, nonce string, body []byte) (string, error)
+}
+
+// SignaturePayload returns the message that must be authenticated with HMAC-SHA512
+// and the base64 decoded API secret to sign a request: URI path + SHA256(nonce + POST data).
+func SignaturePayload(path, nonce string, body []byte) []byte {
+	sha := sha256.New()
+	sha.Write([]byte(nonce))
+	sha.Write(body)
+
+	return append([]byte(path), sha.Sum(nil)...)
+}
+
 // Signer represents a Kraken API signature.
+// It is the default RequestSigner, holding the API secret in memory.
 type Signer struct {
 	Secret Secret
 }
@@ -18,21 +40,31 @@ type Signer struct {
 // Authenticated requests should be signed with the "API-Sign" header,
 // using a signature generated with your private key, nonce, encoded payload, and URI path according to:
 // HMAC-SHA512 of (URI path + SHA256(nonce + POST data)) and base64 decoded secret API key.
-func NewSigner(s string) Signer {
-	secret, _ := base64.StdEncoding.DecodeString(s)
+// An error is returned if the secret is not valid base64.
+func NewSigner(s string) (Signer, error) {
+	secret, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return Signer{}, fmt.Errorf("invalid API secret: %w", err)
+	}
 
 	return Signer{
 		Secret: Secret(secret),
-	}
+	}, nil
 }
 
 // Sign signs the Kraken API request.
 // Docs: https://www.kraken.com/help/api#general-usage for more information.
 func (s Signer) Sign(v reqBody, path string) string {
-	sha := sha256.New()
-	sha.Write([]byte(v.nonce() + v.string()))
+	return s.sign(path, v.nonce(), []byte(v.string()))
+}
+
+// SignRequest signs the Kraken API request with the HMAC-SHA512 of the signature payload.
+func (s Signer) SignRequest(_ context.Context, path, nonce string, body []byte) (string, error) {
+	return s.sign(path, nonce, body), nil
+}
 
+func (s Signer) sign(path, nonce string, body []byte) string {
 	mac := hmac.New(sha512.New, s.Secret)
-	mac.Write(append([]byte(path), sha.Sum(nil)...))
+	mac.Write(SignaturePayload(path, nonce, body))
 	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
 }
@@ -1,6 +1,11 @@
 package kraken
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"testing"
 )
@@ -41,10 +46,105 @@ func TestSignature_Sign(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			s := NewSigner(tt.fields.Secret)
+			s, err := NewSigner(tt.fields.Secret)
+			if err != nil {
+				t.Fatalf("NewSigner() error = %v", err)
+			}
 			if got := s.Sign(tt.args.v, tt.args.path); got != tt.want {
 				t.Errorf("Signature.Sign() = %v, want %v", got, tt.want)
 			}
+
+			got, err := s.SignRequest(context.Background(), tt.args.path, tt.args.v.nonce(), []byte(tt.args.v.string()))
+			if err != nil {
+				t.Fatalf("Signature.SignRequest() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Signature.SignRequest() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewSigner(t *testing.T) {
+	tests := []struct {
+		name    string
+		secret  string
+		wantErr bool
+	}{
+		{name: "valid secret", secret: "c2VjcmV0"},
+		{name: "empty secret", secret: ""},
+		{name: "malformed secret", secret: "not base64!", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewSigner(tt.secret); (err != nil) != tt.wantErr {
+				t.Errorf("NewSigner() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+type fakeSigner struct {
+	signature string
+	err       error
+}
+
+func (s fakeSigner) SignRequest(_ context.Context, _, _ string, _ []byte) (string, error) {
+	return s.signature, s.err
+}
+
+func TestClient_WithSigner(t *testing.T) {
+	errSigner := errors.New("signer unavailable")
+
+	tests := []struct {
+		name    string
+		client  func(c *Client) *Client
+		wantErr error
+	}{
+		{
+			name: "malformed secret",
+			client: func(c *Client) *Client {
+				return c.WithAuth(Secrets{Key: "key", Secret: "not base64!"})
+			},
+			wantErr: base64.CorruptInputError(3),
+		},
+		{
+			name: "signer error",
+			client: func(c *Client) *Client {
+				return c.WithSigner("key", fakeSigner{err: errSigner})
+			},
+			wantErr: errSigner,
+		},
+		{
+			name: "remote signer",
+			client: func(c *Client) *Client {
+				return c.WithSigner("key", fakeSigner{signature: "signature"})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sign string
+
+			apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sign = r.Header.Get("API-Sign")
+				http.ServeFile(w, r, "testdata/account_balance.json")
+			}))
+			defer apiMock.Close()
+
+			baseURL, _ := url.Parse(apiMock.URL + "/")
+
+			c := tt.client(New(apiMock.Client()))
+			c.baseURL = baseURL
+
+			_, err := c.Account.Balance(context.Background())
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Account.Balance() error = %v, want %v", err, tt.wantErr)
+				return
+			}
+			if err == nil && sign != "signature" {
+				t.Errorf("API-Sign = %v, want %v", sign, "signature")
+			}
 		})
 	}
 }
@@ -50,10 +50,10 @@ func (s *Subaccounts) Create(ctx context.Context, opts CreateSubaccountOpts) (bo
 
 // TransferOpts represents the parameters to transfer funds.
 type TransferOpts struct {
-	Asset  string `url:"asset,omitempty"`
-	Amount string `url:"amount,omitempty"`
-	From   string `url:"from,omitempty"`
-	To     string `url:"to,omitempty"`
+	Asset  string  `url:"asset,omitempty"`
+	Amount Decimal `url:"amount,omitempty"`
+	From   string  `url:"from,omitempty"`
+	To     string  `url:"to,omitempty"`
 }
 
 // Valid returns true if the TransferOpts is valid.
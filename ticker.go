@@ -0,0 +1,115 @@
+package kraken
+
+// PriceLevel represents the best ask or bid of a ticker.
+type PriceLevel struct {
+	Price          Decimal
+	WholeLotVolume Decimal
+	LotVolume      Decimal
+}
+
+// LastTrade represents the last trade closed of a ticker.
+type LastTrade struct {
+	Price  Decimal
+	Volume Decimal
+}
+
+// Rolling represents a value of a ticker computed for today, since midnight UTC,
+// and for the last 24 hours.
+type Rolling struct {
+	Today       Decimal
+	Last24Hours Decimal
+}
+
+// TradeCount represents the number of trades of a ticker for today and for the last 24 hours.
+type TradeCount struct {
+	Today       int
+	Last24Hours int
+}
+
+// TickerSummary is a typed view of the ticker information of an asset pair.
+type TickerSummary struct {
+	Ask    PriceLevel
+	Bid    PriceLevel
+	Last   LastTrade
+	Volume Rolling
+	VWAP   Rolling
+	Trades TradeCount
+	Low    Rolling
+	High   Rolling
+	Open   Decimal // Today's opening price.
+}
+
+// Spread returns the difference between the best ask and the best bid.
+func (t TickerSummary) Spread() Decimal {
+	return t.Ask.Price.Sub(t.Bid.Price)
+}
+
+// Mid returns the price halfway between the best ask and the best bid.
+func (t TickerSummary) Mid() Decimal {
+	return t.Ask.Price.Add(t.Bid.Price).Mul("0.5")
+}
+
+// Summary returns the typed view of the ticker information.
+// Missing positions are left empty.
+func (a AssetTickerInfo) Summary() TickerSummary {
+	return TickerSummary{
+		Ask: PriceLevel{
+			Price:          decimalAt(a.Ask, 0),
+			WholeLotVolume: decimalAt(a.Ask, 1),
+			LotVolume:      decimalAt(a.Ask, 2),
+		},
+		Bid: PriceLevel{
+			Price:          decimalAt(a.Bid, 0),
+			WholeLotVolume: decimalAt(a.Bid, 1),
+			LotVolume:      decimalAt(a.Bid, 2),
+		},
+		Last: LastTrade{
+			Price:  decimalAt(a.Last, 0),
+			Volume: decimalAt(a.Last, 1),
+		},
+		Volume: rollingOf(a.Volume),
+		VWAP:   rollingOf(a.VolumeWeightedAveragePrice),
+		Trades: TradeCount{
+			Today:       intAt(a.NumberOfTrades, 0),
+			Last24Hours: intAt(a.NumberOfTrades, 1),
+		},
+		Low:  rollingOf(a.Low),
+		High: rollingOf(a.High),
+		Open: a.OpeningPrice,
+	}
+}
+
+// Summary returns the typed view of the ticker information of an asset pair.
+func (t Tickers) Summary(assetPair AssetPair) TickerSummary {
+	return t[assetPair].Summary()
+}
+
+// Summaries returns the typed view of the ticker information of every asset pair.
+func (t Tickers) Summaries() map[AssetPair]TickerSummary {
+	summaries := make(map[AssetPair]TickerSummary, len(t))
+	for pair, info := range t {
+		summaries[pair] = info.Summary()
+	}
+	return summaries
+}
+
+func rollingOf(values []Decimal) Rolling {
+	return Rolling{
+		Today:       decimalAt(values, 0),
+		Last24Hours: decimalAt(values, 1),
+	}
+}
+
+func decimalAt(values []Decimal, i int) Decimal {
+	if i < len(values) {
+		return values[i]
+	}
+	return ""
+}
+
+func intAt(values []int, i int) int {
+	if i < len(values) {
+		return values[i]
+	}
+	return 0
+}
@@ -0,0 +1,108 @@
+package kraken
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestAssetTickerInfo_Summary(t *testing.T) {
+	tests := []struct {
+		name string
+		a    AssetTickerInfo
+		want TickerSummary
+	}{
+		{
+			name: "empty ticker",
+		},
+		{
+			name: "missing positions",
+			a: AssetTickerInfo{
+				Ask:            []Decimal{"30300.10000"},
+				NumberOfTrades: []int{34619},
+			},
+			want: TickerSummary{
+				Ask:    PriceLevel{Price: "30300.10000"},
+				Trades: TradeCount{Today: 34619},
+			},
+		},
+		{
+			name: "ticker",
+			a: AssetTickerInfo{
+				Ask:                        []Decimal{"30300.10000", "1", "1.000"},
+				Bid:                        []Decimal{"30300.00000", "1", "1.000"},
+				Last:                       []Decimal{"30303.20000", "0.00067643"},
+				Volume:                     []Decimal{"4083.67001100", "4412.73601799"},
+				VolumeWeightedAveragePrice: []Decimal{"30706.77771", "30689.13205"},
+				NumberOfTrades:             []int{34619, 38907},
+				Low:                        []Decimal{"29868.30000", "29868.30000"},
+				High:                       []Decimal{"31631.00000", "31631.00000"},
+				OpeningPrice:               "30502.80000",
+			},
+			want: TickerSummary{
+				Ask:    PriceLevel{Price: "30300.10000", WholeLotVolume: "1", LotVolume: "1.000"},
+				Bid:    PriceLevel{Price: "30300.00000", WholeLotVolume: "1", LotVolume: "1.000"},
+				Last:   LastTrade{Price: "30303.20000", Volume: "0.00067643"},
+				Volume: Rolling{Today: "4083.67001100", Last24Hours: "4412.73601799"},
+				VWAP:   Rolling{Today: "30706.77771", Last24Hours: "30689.13205"},
+				Trades: TradeCount{Today: 34619, Last24Hours: 38907},
+				Low:    Rolling{Today: "29868.30000", Last24Hours: "29868.30000"},
+				High:   Rolling{Today: "31631.00000", Last24Hours: "31631.00000"},
+				Open:   "30502.80000",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Summary(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AssetTickerInfo.Summary() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTickerSummary_SpreadMid(t *testing.T) {
+	s := TickerSummary{
+		Ask: PriceLevel{Price: "30300.10000"},
+		Bid: PriceLevel{Price: "30300.00000"},
+	}
+
+	if got := s.Spread(); !got.Equal("0.1") {
+		t.Errorf("TickerSummary.Spread() = %v, want %v", got, "0.1")
+	}
+	if got := s.Mid(); !got.Equal("30300.05") {
+		t.Errorf("TickerSummary.Mid() = %v, want %v", got, "30300.05")
+	}
+}
+
+func TestMarketData_TickerInformation_allPairs(t *testing.T) {
+	apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/public/Ticker" || r.URL.RawQuery != "" {
+			t.Errorf("request = %v, want /public/Ticker without query", r.URL)
+		}
+		http.ServeFile(w, r, "testdata/tickers.json")
+	}))
+	defer apiMock.Close()
+
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	c := New(apiMock.Client())
+	c.baseURL = baseURL
+
+	got, err := c.Market.TickerInformation(context.Background(), TickerInformationOpts{})
+	if err != nil {
+		t.Fatalf("MarketData.TickerInformation() error = %v", err)
+	}
+
+	summaries := got.Summaries()
+	if len(summaries) != 2 {
+		t.Fatalf("Tickers.Summaries() = %v pairs, want %v", len(summaries), 2)
+	}
+
+	if eth := got.Summary(XETHZUSD); !eth.Spread().Equal("0.01") || eth.Trades.Last24Hours != 19275 {
+		t.Errorf("Tickers.Summary() = %v, want spread 0.01 and 19275 trades", eth)
+	}
+}
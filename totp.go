@@ -0,0 +1,129 @@
+package kraken
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // RFC 6238 mandates HMAC-SHA1.
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// OtpProvider provides the one-time password of private requests.
+// A one-time password set in the context with ContextWithOtp takes precedence over the provider.
+type OtpProvider interface {
+	Otp(ctx context.Context) (Otp, error)
+}
+
+const (
+	totpDigits = 6
+	totpPeriod = 30 * time.Second
+)
+
+// ErrOtpUsed is returned by TOTP.Otp when the password of the current time step
+// was already used and the generator does not wait for the next one.
+var ErrOtpUsed = errors.New("one-time password of the current time step already used")
+
+// TOTP generates RFC 6238 time-based one-time passwords, as the authenticator
+// apps used to protect Kraken API keys with 2FA. Each password is only returned once,
+// so a key protected by a TOTP sends at most one private request every 30 seconds,
+// retries included. It is safe for concurrent use.
+type TOTP struct {
+	// Wait makes Otp wait for the next time step, up to 30 seconds, when the password
+	// of the current one was already used, instead of returning ErrOtpUsed.
+	Wait bool
+
+	secret []byte
+
+	mu       sync.Mutex
+	lastStep uint64
+	used     bool
+
+	now func() time.Time
+}
+
+// NewTOTP returns a new TOTP generator for the base32 encoded seed
+// shown when 2FA is enabled on the API key.
+func NewTOTP(seed string) (*TOTP, error) {
+	seed = strings.ToUpper(strings.ReplaceAll(seed, " ", ""))
+	seed = strings.TrimRight(seed, "=")
+
+	secret, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(seed)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP seed: %w", err)
+	}
+
+	return &TOTP{secret: secret, now: time.Now}, nil
+}
+
+// Generate returns the one-time password for the given time.
+func (t *TOTP) Generate(at time.Time) Otp {
+	return t.generate(step(at))
+}
+
+// Otp returns the one-time password of the current time step. If it was already returned,
+// Otp fails with ErrOtpUsed or, if Wait is set, waits for the next time step. Concurrent
+// callers waiting for the same time step get the passwords of the following ones.
+func (t *TOTP) Otp(ctx context.Context) (Otp, error) {
+	for {
+		t.mu.Lock()
+
+		now := t.now()
+		s := step(now)
+
+		if !t.used || s > t.lastStep {
+			t.lastStep = s
+			t.used = true
+			t.mu.Unlock()
+
+			return t.generate(s), nil
+		}
+
+		if !t.Wait {
+			t.mu.Unlock()
+			return "", ErrOtpUsed
+		}
+
+		s = t.lastStep + 1
+		t.mu.Unlock()
+
+		// The lock is released while waiting, so that other callers can honor their context.
+		next := time.Unix(int64(s)*int64(totpPeriod/time.Second), 0)
+		if err := sleep(ctx, next.Sub(now)); err != nil {
+			return "", err
+		}
+
+		t.mu.Lock()
+		if t.lastStep < s {
+			t.lastStep = s
+			t.mu.Unlock()
+
+			return t.generate(s), nil
+		}
+		t.mu.Unlock()
+	}
+}
+
+func (t *TOTP) generate(s uint64) Otp {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], s)
+
+	mac := hmac.New(sha1.New, t.secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, see RFC 4226 section 5.3.
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return Otp(fmt.Sprintf("%0*d", totpDigits, code%1_000_000))
+}
+
+// step returns the RFC 6238 time step of the given time.
+func step(t time.Time) uint64 {
+	return uint64(t.Unix()) / uint64(totpPeriod/time.Second)
+}
@@ -0,0 +1,170 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+// rfc6238Seed is the base32 encoding of the RFC 6238 SHA1 test secret "12345678901234567890".
+const rfc6238Seed = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestTOTP_Generate(t *testing.T) {
+	tests := []struct {
+		name string
+		at   int64
+		want Otp
+	}{
+		{name: "59", at: 59, want: "287082"},
+		{name: "1111111109", at: 1111111109, want: "081804"},
+		{name: "1111111111", at: 1111111111, want: "050471"},
+		{name: "1234567890", at: 1234567890, want: "005924"},
+		{name: "2000000000", at: 2000000000, want: "279037"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			totp, err := NewTOTP(rfc6238Seed)
+			if err != nil {
+				t.Fatalf("NewTOTP() error = %v", err)
+			}
+			if got := totp.Generate(time.Unix(tt.at, 0)); got != tt.want {
+				t.Errorf("TOTP.Generate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTOTP(t *testing.T) {
+	tests := []struct {
+		name    string
+		seed    string
+		wantErr bool
+	}{
+		{name: "seed", seed: rfc6238Seed},
+		{name: "lower case seed with spaces", seed: "gezd gnbv gy3t qojq gezd gnbv gy3t qojq"},
+		{name: "padded seed", seed: "MZXW6==="},
+		{name: "invalid seed", seed: "not a seed!", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewTOTP(tt.seed); (err != nil) != tt.wantErr {
+				t.Errorf("NewTOTP() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTOTP_Otp(t *testing.T) {
+	totp, _ := NewTOTP(rfc6238Seed)
+
+	// The next time step starts 10ms after the current time.
+	now := time.Unix(1111111110, 0).Add(-10 * time.Millisecond)
+	totp.now = func() time.Time { return now }
+
+	first, err := totp.Otp(context.Background())
+	if err != nil || first != "081804" {
+		t.Fatalf("TOTP.Otp() = %v, %v, want %v", first, err, "081804")
+	}
+
+	if _, err := totp.Otp(context.Background()); !errors.Is(err, ErrOtpUsed) {
+		t.Errorf("TOTP.Otp() error = %v, want %v", err, ErrOtpUsed)
+	}
+
+	totp.Wait = true
+
+	second, err := totp.Otp(context.Background())
+	if err != nil || second != totp.Generate(time.Unix(1111111110, 0)) {
+		t.Errorf("TOTP.Otp() = %v, %v, want the password of the next time step", second, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := totp.Otp(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("TOTP.Otp() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestTOTP_Otp_concurrent(t *testing.T) {
+	totp, _ := NewTOTP(rfc6238Seed)
+
+	// The next time step starts 20s after the current time.
+	now := time.Unix(1111111110, 0).Add(-20 * time.Second)
+	totp.now = func() time.Time { return now }
+	totp.Wait = true
+
+	if _, err := totp.Otp(context.Background()); err != nil {
+		t.Fatalf("TOTP.Otp() error = %v", err)
+	}
+
+	waiting, cancelWaiting := context.WithCancel(context.Background())
+	defer cancelWaiting()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := totp.Otp(waiting)
+		done <- err
+	}()
+
+	// A caller waiting for the next time step must not block the others.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := totp.Otp(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("TOTP.Otp() error = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("TOTP.Otp() returned after %v, want it to honor its context", elapsed)
+	}
+
+	cancelWaiting()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("TOTP.Otp() error = %v, want context.Canceled", err)
+	}
+}
+
+type staticOtp Otp
+
+func (o staticOtp) Otp(_ context.Context) (Otp, error) {
+	return Otp(o), nil
+}
+
+func TestClient_WithOtpProvider(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "otp from provider", ctx: context.Background(), want: "123456"},
+		{name: "otp from context", ctx: ContextWithOtp(context.Background(), "654321"), want: "654321"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var otp string
+
+			apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				otp = r.PostForm.Get("otp")
+				http.ServeFile(w, r, "testdata/account_balance.json")
+			}))
+			defer apiMock.Close()
+
+			baseURL, _ := url.Parse(apiMock.URL + "/")
+
+			c := New(apiMock.Client()).WithOtpProvider(staticOtp("123456"))
+			c.baseURL = baseURL
+
+			if _, err := c.Account.Balance(tt.ctx); err != nil {
+				t.Fatalf("Account.Balance() error = %v", err)
+			}
+			if otp != tt.want {
+				t.Errorf("otp = %v, want %v", otp, tt.want)
+			}
+		})
+	}
+}
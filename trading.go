@@ -2,7 +2,11 @@ package kraken
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -15,31 +19,43 @@ type Trading service
 // AddOrderOpts represents the parameters to create an Order.
 type AddOrderOpts struct {
 	UserRef        string         `url:"userref,omitempty"`
+	ClientOrderID  string         `url:"cl_ord_id,omitempty"`
 	OrderType      OrderType      `url:"ordertype,omitempty"`
 	Type           OrderDirection `url:"type,omitempty"`
-	Volume         string         `url:"volume,omitempty"`
-	DisplayVol     string         `url:"displayvol,omitempty"`
+	Volume         Decimal        `url:"volume,omitempty"`
+	DisplayVol     Decimal        `url:"displayvol,omitempty"`
 	Pair           string         `url:"pair,omitempty"`
-	Price          string         `url:"price,omitempty"`
-	Price2         string         `url:"price2,omitempty"`
+	Price          OrderPrice     `url:"price,omitempty"`
+	Price2         OrderPrice     `url:"price2,omitempty"`
 	Trigger        OrderTrigger   `url:"trigger,omitempty"`
 	Leverage       string         `url:"leverage,omitempty"`
 	ReduceOnly     bool           `url:"reduce_only,omitempty"`
 	StopType       StopType       `url:"stptype,omitempty"`
-	OrderFlags     string         `url:"oflags,omitempty"`
+	OrderFlags     OrderFlags     `url:"oflags,omitempty"`
 	TimeInForce    TimeInForce    `url:"timeinforce,omitempty"`
-	Starttm        string         `url:"starttm,omitempty"`
-	Expiretm       string         `url:"expiretm,omitempty"`
+	Starttm        OrderTime      `url:"starttm,omitempty"`
+	Expiretm       OrderTime      `url:"expiretm,omitempty"`
 	CloseOrderType OrderType      `url:"close[ordertype],omitempty"`
-	ClosePrice     string         `url:"close[price],omitempty"`
-	ClosePrice2    string         `url:"close[price2],omitempty"`
-	Deadline       string         `url:"deadline,omitempty"`
+	ClosePrice     OrderPrice     `url:"close[price],omitempty"`
+	ClosePrice2    OrderPrice     `url:"close[price2],omitempty"`
+	Deadline       OrderDeadline  `url:"deadline,omitempty"`
 	Validate       bool           `url:"validate,omitempty"`
 }
 
 // AddOrder places a new order.
 // Docs: https://docs.kraken.com/rest/#tag/Trading/operation/addOrder
 func (t *Trading) AddOrder(ctx context.Context, opts AddOrderOpts) (*OrderCreation, error) {
+	if v := t.client.orderValidator; v != nil {
+		var err error
+		if opts, err = v.Validate(opts); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkDeadline(opts.Deadline, time.Now()); err != nil {
+		return nil, err
+	}
+
 	body, err := query.Values(opts)
 	if err != nil {
 		return nil, err
@@ -50,7 +66,59 @@ func (t *Trading) AddOrder(ctx context.Context, opts AddOrderOpts) (*OrderCreati
 		return nil, err
 	}
 
+	pair := AssetPair(opts.Pair)
+
+	// Validated orders are not sent to the matching engine.
+	limiter := t.client.tradingRateLimiter
+	if opts.Validate {
+		limiter = nil
+	}
+
+	if limiter != nil {
+		req = withReservation(req, func(ctx context.Context) error {
+			return limiter.WaitAddOrder(ctx, pair)
+		})
+	}
+
 	var v OrderCreation
+	err = t.client.do(req, &v)
+
+	if limiter != nil {
+		limiter.observe(pair, err)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if limiter != nil {
+		limiter.TrackOrders(pair, v.Transaction...)
+	}
+
+	return &v, nil
+}
+
+// OpenOrdersOpts represents the parameters to retrieve the open orders.
+type OpenOrdersOpts struct {
+	Trades        bool   `url:"trades,omitempty"`
+	UserRef       string `url:"userref,omitempty"`
+	ClientOrderID string `url:"cl_ord_id,omitempty"`
+}
+
+// OpenOrders retrieves the information about the currently open orders.
+// Docs: https://docs.kraken.com/rest/#tag/Account-Data/operation/getOpenOrders
+func (t *Trading) OpenOrders(ctx context.Context, opts OpenOrdersOpts) (*OpenOrders, error) {
+	body, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := t.client.newPrivateRequest(ctx, http.MethodPost, "OpenOrders", newFormURLEncodedBody(body))
+	if err != nil {
+		return nil, err
+	}
+
+	var v OpenOrders
 	if err := t.client.do(req, &v); err != nil {
 		return nil, err
 	}
@@ -76,11 +144,98 @@ func (t *Trading) CancelOrder(ctx context.Context, opts CancelOrderOpts) (*Order
 		return nil, err
 	}
 
+	txid := TransactionID(opts.TransactionID)
+
+	limiter := t.client.tradingRateLimiter
+	if limiter != nil {
+		req = withReservation(req, func(ctx context.Context) error {
+			return limiter.WaitCancelOrder(ctx, txid)
+		})
+	}
+
 	var v OrderCancelation
-	if err := t.client.do(req, &v); err != nil {
+	err = t.client.do(req, &v)
+
+	if limiter != nil {
+		if pair, ok := limiter.orderPair(txid); ok {
+			limiter.observe(pair, err)
+		}
+		if err == nil || errors.Is(err, ErrOrderNotFound) {
+			limiter.ForgetOrders(txid)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// EditOrderOpts represents the parameters to edit an open order.
+type EditOrderOpts struct {
+	UserRef        string        `url:"userref,omitempty"`
+	TransactionID  string        `url:"txid,omitempty"`
+	Volume         Decimal       `url:"volume,omitempty"`
+	DisplayVol     Decimal       `url:"displayvol,omitempty"`
+	Pair           string        `url:"pair,omitempty"`
+	Price          OrderPrice    `url:"price,omitempty"`
+	Price2         OrderPrice    `url:"price2,omitempty"`
+	OrderFlags     OrderFlags    `url:"oflags,omitempty"`
+	Deadline       OrderDeadline `url:"deadline,omitempty"`
+	CancelResponse bool          `url:"cancel_response,omitempty"`
+	Validate       bool          `url:"validate,omitempty"`
+}
+
+// EditOrder edits the volume and price of an open order. The order is replaced
+// by a new one with a new transaction ID.
+// Docs: https://docs.kraken.com/rest/#tag/Trading/operation/editOrder
+func (t *Trading) EditOrder(ctx context.Context, opts EditOrderOpts) (*OrderEdition, error) {
+	if err := checkDeadline(opts.Deadline, time.Now()); err != nil {
+		return nil, err
+	}
+
+	body, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := t.client.newPrivateRequest(ctx, http.MethodPost, "EditOrder", newFormURLEncodedBody(body))
+	if err != nil {
+		return nil, err
+	}
+
+	txid := TransactionID(opts.TransactionID)
+
+	// Validated edits are not sent to the matching engine.
+	limiter := t.client.tradingRateLimiter
+	if opts.Validate {
+		limiter = nil
+	}
+
+	if limiter != nil {
+		req = withReservation(req, func(ctx context.Context) error {
+			return limiter.WaitEditOrder(ctx, txid)
+		})
+	}
+
+	var v OrderEdition
+	err = t.client.do(req, &v)
+
+	if limiter != nil {
+		if pair, ok := limiter.orderPair(txid); ok {
+			limiter.observe(pair, err)
+		}
+	}
+
+	if err != nil {
 		return nil, err
 	}
 
+	if limiter != nil && v.Transaction != "" {
+		limiter.ReplaceOrder(txid, v.Transaction)
+	}
+
 	return &v, nil
 }
 
@@ -97,22 +252,30 @@ func (t *Trading) CancelAllOrders(ctx context.Context) (*OrderCancelation, error
 		return nil, err
 	}
 
+	if limiter := t.client.tradingRateLimiter; limiter != nil {
+		limiter.forgetAll()
+	}
+
 	return &v, nil
 }
 
 // CancelAllOrdersAfterOpts represents the parameters to cancel all orders after a timeout.
 type CancelAllOrdersAfterOpts struct {
-	Timeout time.Duration `url:"timeout,omitempty"` // Duration (in seconds) to set/extend the timer by
+	// Timeout is the duration to set or extend the timer by. It's sent in seconds,
+	// rounded up, and a zero timeout disables the timer.
+	Timeout time.Duration `url:"-"`
 }
 
 // CancelAllOrdersAfter cancels all open orders after a timeout.
 // Docs: https://docs.kraken.com/rest/#tag/Trading/operation/cancelAllOrdersAfter
 func (t *Trading) CancelAllOrdersAfter(ctx context.Context, opts CancelAllOrdersAfterOpts) (*TriggeredOrderCancellation, error) {
-	body, err := query.Values(opts)
-	if err != nil {
-		return nil, err
+	if opts.Timeout < 0 {
+		return nil, fmt.Errorf("timeout %v must not be negative", opts.Timeout)
 	}
 
+	// The timeout is always sent, as zero disables the timer.
+	body := url.Values{"timeout": {strconv.FormatInt(seconds(opts.Timeout), 10)}}
+
 	req, err := t.client.newPrivateRequest(ctx, http.MethodPost, "CancelAllOrdersAfter", newFormURLEncodedBody(body))
 	if err != nil {
 		return nil, err
@@ -125,3 +288,8 @@ func (t *Trading) CancelAllOrdersAfter(ctx context.Context, opts CancelAllOrders
 
 	return &v, nil
 }
+
+// seconds returns the duration in whole seconds, rounded up.
+func seconds(d time.Duration) int64 {
+	return int64((d + time.Second - 1) / time.Second)
+}
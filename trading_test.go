@@ -87,6 +87,89 @@ func TestTrading_AddOrder(t *testing.T) {
 	}
 }
 
+func TestTrading_OpenOrders(t *testing.T) {
+	ctx := context.Background()
+
+	type fields struct {
+		apiMock *httptest.Server
+	}
+	type args struct {
+		ctx  context.Context
+		opts OpenOrdersOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *OpenOrders
+		wantErr bool
+	}{
+		{
+			name: "error building request",
+			fields: fields{
+				apiMock: createFakeServer(http.StatusOK, ""),
+			},
+			args: args{
+				opts: OpenOrdersOpts{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "open orders",
+			fields: fields{
+				apiMock: createFakeServer(http.StatusOK, "open_orders.json"),
+			},
+			args: args{
+				ctx:  ctx,
+				opts: OpenOrdersOpts{Trades: true},
+			},
+			want: &OpenOrders{
+				Open: map[TransactionID]OrderInfo{
+					"OQCLML-BW3P3-BUCMWZ": {
+						Status:   OrderOpen,
+						OpenTime: 1688666559.8974,
+						Description: OrderDescription{
+							AssetPair:      "XBTUSD",
+							Leverage:       "none",
+							Order:          "buy 1.25000000 XBTUSD @ limit 30010.0",
+							OrderType:      "limit",
+							PrimaryPrice:   "30010.0",
+							SecondaryPrice: "0",
+							Type:           "buy",
+						},
+						Volume:         "1.25000000",
+						VolumeExecuted: "0.37500000",
+						Cost:           "11253.7",
+						Fee:            "0.00000",
+						Price:          "30010.0",
+						StopPrice:      "0.00000",
+						LimitPrice:     "0.00000",
+						OrderFlags:     "fciq",
+						Trades:         []TransactionID{"TCCCTY-WE2O6-P3NB37"},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseURL, _ := url.Parse(tt.fields.apiMock.URL + "/")
+
+			c := New(tt.fields.apiMock.Client())
+			c.baseURL = baseURL
+
+			got, err := c.Trading.OpenOrders(tt.args.ctx, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Trading.OpenOrders() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Trading.OpenOrders() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTrading_CancelOrder(t *testing.T) {
 	ctx := context.Background()
 
@@ -145,6 +228,84 @@ func TestTrading_CancelOrder(t *testing.T) {
 	}
 }
 
+func TestTrading_EditOrder(t *testing.T) {
+	ctx := context.Background()
+
+	type fields struct {
+		apiMock *httptest.Server
+	}
+	type args struct {
+		ctx  context.Context
+		opts EditOrderOpts
+	}
+	tests := []struct {
+		name    string
+		fields  fields
+		args    args
+		want    *OrderEdition
+		wantErr bool
+	}{
+		{
+			name: "error building request",
+			fields: fields{
+				apiMock: createFakeServer(http.StatusOK, ""),
+			},
+			args: args{
+				opts: EditOrderOpts{},
+			},
+			wantErr: true,
+		},
+		{
+			name: "deadline too far",
+			fields: fields{
+				apiMock: createFakeServer(http.StatusOK, "edit_order.json"),
+			},
+			args: args{
+				ctx:  ctx,
+				opts: EditOrderOpts{TransactionID: "OUF4EM-FRGI2-MQMWZD", Pair: "XXBTZUSD", Deadline: DeadlineIn(time.Hour)},
+			},
+			wantErr: true,
+		},
+		{
+			name: "edit order",
+			fields: fields{
+				apiMock: createFakeServer(http.StatusOK, "edit_order.json"),
+			},
+			args: args{
+				ctx:  ctx,
+				opts: EditOrderOpts{TransactionID: "OUF4EM-FRGI2-MQMWZD", Pair: "XXBTZUSD", Volume: "0.0003", Price: "19500.0"},
+			},
+			want: &OrderEdition{
+				Description:         OrderDescription{Order: "buy 0.00030000 XXBTZUSD @ limit 19500.0"},
+				Transaction:         "OFVXHJ-KPQ3B-VS7ELA",
+				OriginalTransaction: "OUF4EM-FRGI2-MQMWZD",
+				OrdersCancelled:     1,
+				Status:              "ok",
+				Volume:              "0.00030000",
+				Price:               "19500.0",
+				Price2:              "32500.0",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseURL, _ := url.Parse(tt.fields.apiMock.URL + "/")
+
+			c := New(tt.fields.apiMock.Client())
+			c.baseURL = baseURL
+
+			got, err := c.Trading.EditOrder(tt.args.ctx, tt.args.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Trading.EditOrder() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Trading.EditOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestTrading_CancelAllOrders(t *testing.T) {
 	ctx := context.Background()
 
@@ -253,3 +414,42 @@ func TestTrading_CancelAllOrdersAfter(t *testing.T) {
 		})
 	}
 }
+
+func TestTrading_CancelAllOrdersAfter_timeout(t *testing.T) {
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		want    string
+		wantErr bool
+	}{
+		{name: "seconds", timeout: time.Minute, want: "60"},
+		{name: "rounded up to the second", timeout: 1500 * time.Millisecond, want: "2"},
+		{name: "zero disables the timer", timeout: 0, want: "0"},
+		{name: "negative", timeout: -time.Second, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got string
+
+			apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_ = r.ParseForm()
+				got = r.PostForm.Get("timeout")
+				http.ServeFile(w, r, "testdata/cancel_all_orders_after.json")
+			}))
+			defer apiMock.Close()
+
+			baseURL, _ := url.Parse(apiMock.URL + "/")
+
+			c := New(apiMock.Client())
+			c.baseURL = baseURL
+
+			_, err := c.Trading.CancelAllOrdersAfter(context.Background(), CancelAllOrdersAfterOpts{Timeout: tt.timeout})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Trading.CancelAllOrdersAfter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("timeout = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,6 @@
 package kraken
 
 import (
-	"strconv"
 	"time"
 )
 
@@ -23,8 +22,10 @@ const (
 	CancelOnly Status = "cancel_only"
 	// PostOnly means only post-only limit orders can be submitted. Existing orders may still be cancelled. No trades will occur.
 	PostOnly Status = "post_only"
-	// ReduceOnly .
+	// ReduceOnly means only orders that reduce an open position can be submitted.
 	ReduceOnly Status = "reduce_only"
+	// LimitOnly means only limit orders can be submitted.
+	LimitOnly Status = "limit_only"
 )
 
 // SystemStatus represents the current system status.
@@ -34,23 +35,20 @@ type SystemStatus struct {
 }
 
 // Balance represents the user's balance.
-type Balance string
-
-// Float64 returns the balance as a float64.
-func (b Balance) Float64() float64 {
-	fValue, err := strconv.ParseFloat(string(b), 64)
-	if err != nil {
-		return 0
-	}
-	return fValue
-}
+type Balance = Decimal
 
 // ExtendedBalance represents the user's extended balance.
 type ExtendedBalance struct {
 	Balance    Balance `json:"balance"`
-	Credit     string  `json:"credit"`
-	CreditUsed string  `json:"credit_used"`
-	HoldTrade  string  `json:"hold_trade"`
+	Credit     Decimal `json:"credit"`
+	CreditUsed Decimal `json:"credit_used"`
+	HoldTrade  Decimal `json:"hold_trade"`
+}
+
+// Available returns the balance available for trading:
+// balance + credit - credit_used - hold_trade.
+func (b ExtendedBalance) Available() Decimal {
+	return b.Balance.Add(b.Credit).Sub(b.CreditUsed).Sub(b.HoldTrade)
 }
 
 type (
@@ -64,13 +62,15 @@ type (
 type OrderType string
 
 const (
-	Market          OrderType = "market"
-	Limit           OrderType = "limit"
-	StopLoss        OrderType = "stop-loss"
-	TakeProfit      OrderType = "take-profit"
-	StopLossLimit   OrderType = "stop-loss-limit"
-	TakeProfitLimit OrderType = "take-profit-limit"
-	SettlePosition  OrderType = "settle-position"
+	Market            OrderType = "market"
+	Limit             OrderType = "limit"
+	StopLoss          OrderType = "stop-loss"
+	TakeProfit        OrderType = "take-profit"
+	StopLossLimit     OrderType = "stop-loss-limit"
+	TakeProfitLimit   OrderType = "take-profit-limit"
+	TrailingStop      OrderType = "trailing-stop"
+	TrailingStopLimit OrderType = "trailing-stop-limit"
+	SettlePosition    OrderType = "settle-position"
 )
 
 // OrderDirection defines the order direction.
@@ -107,6 +107,22 @@ const (
 	GoodTillDate      TimeInForce = "GTD"
 )
 
+// OrderFlag defines an order flag.
+type OrderFlag string
+
+const (
+	// OrderFlagPost makes a limit order be cancelled instead of taking liquidity.
+	OrderFlagPost OrderFlag = "post"
+	// OrderFlagFeeInBase charges the fee in the base asset.
+	OrderFlagFeeInBase OrderFlag = "fcib"
+	// OrderFlagFeeInQuote charges the fee in the quote asset.
+	OrderFlagFeeInQuote OrderFlag = "fciq"
+	// OrderFlagNoMarketPriceProtection disables the market price protection of market orders.
+	OrderFlagNoMarketPriceProtection OrderFlag = "nompp"
+	// OrderFlagVolumeInQuote expresses the volume of a market buy order in the quote asset.
+	OrderFlagVolumeInQuote OrderFlag = "viqc"
+)
+
 // OrderDescription defines an orders description.
 type OrderDescription struct {
 	AssetPair      string `json:"pair"`
@@ -128,6 +144,21 @@ type OrderCreation struct {
 	Transaction []TransactionID  `json:"txid"`
 }
 
+// OrderEdition defines the response from the EditOrder method.
+type OrderEdition struct {
+	Description         OrderDescription `json:"descr"`
+	Transaction         TransactionID    `json:"txid"`
+	OriginalTransaction TransactionID    `json:"originaltxid"`
+	NewUserRef          string           `json:"newuserref"`
+	OldUserRef          string           `json:"olduserref"`
+	OrdersCancelled     int              `json:"orders_cancelled"`
+	Status              string           `json:"status"`
+	Volume              Decimal          `json:"volume"`
+	Price               Decimal          `json:"price"`
+	Price2              Decimal          `json:"price2"`
+	ErrorMessage        string           `json:"error_message"`
+}
+
 // OrderCancelation defines the response from the CancelOrder method.
 type OrderCancelation struct {
 	Count int `json:"count"`
@@ -141,6 +172,50 @@ type TriggeredOrderCancellation struct {
 	TriggerTime string `json:"triggerTime"`
 }
 
+// OrderStatus defines the status of an order.
+type OrderStatus string
+
+const (
+	// OrderPending is an order not yet entered in the book.
+	OrderPending OrderStatus = "pending"
+	// OrderOpen is an order in the book, possibly partially filled.
+	OrderOpen OrderStatus = "open"
+	// OrderClosed is a fully filled order.
+	OrderClosed OrderStatus = "closed"
+	// OrderCanceled is an order cancelled before being fully filled.
+	OrderCanceled OrderStatus = "canceled"
+	// OrderExpired is an order that reached its expiration time.
+	OrderExpired OrderStatus = "expired"
+)
+
+// OrderInfo defines the information about an order.
+type OrderInfo struct {
+	RefID          string           `json:"refid"`
+	UserRef        int64            `json:"userref"`
+	ClientOrderID  string           `json:"cl_ord_id"`
+	Status         OrderStatus      `json:"status"`
+	OpenTime       float64          `json:"opentm"`
+	StartTime      float64          `json:"starttm"`
+	ExpireTime     float64          `json:"expiretm"`
+	Description    OrderDescription `json:"descr"`
+	Volume         Decimal          `json:"vol"`
+	VolumeExecuted Decimal          `json:"vol_exec"`
+	Cost           Decimal          `json:"cost"`
+	Fee            Decimal          `json:"fee"`
+	Price          Decimal          `json:"price"`
+	StopPrice      Decimal          `json:"stopprice"`
+	LimitPrice     Decimal          `json:"limitprice"`
+	Trigger        OrderTrigger     `json:"trigger,omitempty"`
+	Misc           string           `json:"misc"`
+	OrderFlags     string           `json:"oflags"`
+	Trades         []TransactionID  `json:"trades,omitempty"`
+}
+
+// OpenOrders defines the response from the OpenOrders method.
+type OpenOrders struct {
+	Open map[TransactionID]OrderInfo `json:"open"`
+}
+
 // PairInfo defines the information about an asset pair.
 type PairInfo string
 
@@ -162,12 +237,12 @@ type TickerValues []any
 
 // Ticker represents a ticker.
 type Ticker struct {
-	Open   string
-	High   string
-	Low    string
-	Close  string
-	Vwap   string
-	Volume string
+	Open   Decimal
+	High   Decimal
+	Low    Decimal
+	Close  Decimal
+	Vwap   Decimal
+	Volume Decimal
 	Count  int64
 	Time   int64
 }
@@ -186,49 +261,49 @@ func (t TickerValues) time() int64 {
 }
 
 // open returns the open price of the tick.
-func (t TickerValues) open() string {
+func (t TickerValues) open() Decimal {
 	if v, ok := t[1].(string); ok {
-		return v
+		return Decimal(v)
 	}
 	return ""
 }
 
 // high returns the high price of the tick.
-func (t TickerValues) high() string {
+func (t TickerValues) high() Decimal {
 	if v, ok := t[2].(string); ok {
-		return v
+		return Decimal(v)
 	}
 	return ""
 }
 
 // low returns the low price of the tick.
-func (t TickerValues) low() string {
+func (t TickerValues) low() Decimal {
 	if v, ok := t[3].(string); ok {
-		return v
+		return Decimal(v)
 	}
 	return ""
 }
 
 // close returns the close price of the tick.
-func (t TickerValues) close() string {
+func (t TickerValues) close() Decimal {
 	if v, ok := t[4].(string); ok {
-		return v
+		return Decimal(v)
 	}
 	return ""
 }
 
 // vwap returns the vwap of the tick.
-func (t TickerValues) vwap() string {
+func (t TickerValues) vwap() Decimal {
 	if v, ok := t[5].(string); ok {
-		return v
+		return Decimal(v)
 	}
 	return ""
 }
 
 // volume returns the volume of the tick.
-func (t TickerValues) volume() string {
+func (t TickerValues) volume() Decimal {
 	if v, ok := t[6].(string); ok {
-		return v
+		return Decimal(v)
 	}
 	return ""
 }
@@ -275,15 +350,15 @@ type OHCL struct {
 
 // AssetTickerInfo defines the information about an asset ticker.
 type AssetTickerInfo struct {
-	Ask                        []string `json:"a"` // Ask price array(<price>, <whole lot volume>, <lot volume>)
-	Bid                        []string `json:"b"` // Bid price array(<price>, <whole lot volume>, <lot volume>)
-	Last                       []string `json:"c"` // Last trade closed array(<price>, <lot volume>)
-	Volume                     []string `json:"v"` // Volume array(<today>, <last 24 hours>)
-	VolumeWeightedAveragePrice []string `json:"p"` // Volume weighted average price array(<today>, <last 24 hours>)
-	NumberOfTrades             []int    `json:"t"` // Number of trades array(<today>, <last 24 hours>)
-	Low                        []string `json:"l"` // Low array(<today>, <last 24 hours>)
-	High                       []string `json:"h"` // High array(<today>, <last 24 hours>)
-	OpeningPrice               string   `json:"o"`
+	Ask                        []Decimal `json:"a"` // Ask price array(<price>, <whole lot volume>, <lot volume>)
+	Bid                        []Decimal `json:"b"` // Bid price array(<price>, <whole lot volume>, <lot volume>)
+	Last                       []Decimal `json:"c"` // Last trade closed array(<price>, <lot volume>)
+	Volume                     []Decimal `json:"v"` // Volume array(<today>, <last 24 hours>)
+	VolumeWeightedAveragePrice []Decimal `json:"p"` // Volume weighted average price array(<today>, <last 24 hours>)
+	NumberOfTrades             []int     `json:"t"` // Number of trades array(<today>, <last 24 hours>)
+	Low                        []Decimal `json:"l"` // Low array(<today>, <last 24 hours>)
+	High                       []Decimal `json:"h"` // High array(<today>, <last 24 hours>)
+	OpeningPrice               Decimal   `json:"o"`
 }
 
 // Tickers defines a map of asset tickers.
@@ -299,23 +374,23 @@ type OrderBookEntries []any
 
 // OrderBookEntry defines an order book entry.
 type OrderBookEntry struct {
-	Price     string
-	Volume    string
+	Price     Decimal
+	Volume    Decimal
 	Timestamp int64
 }
 
 // price returns the price of the order book entries.
-func (o OrderBookEntries) price() string {
+func (o OrderBookEntries) price() Decimal {
 	if v, ok := o[0].(string); ok {
-		return v
+		return Decimal(v)
 	}
 	return ""
 }
 
 // volume returns the volume of the order book entries.
-func (o OrderBookEntries) volume() string {
+func (o OrderBookEntries) volume() Decimal {
 	if v, ok := o[1].(string); ok {
-		return v
+		return Decimal(v)
 	}
 	return ""
 }
@@ -384,8 +459,8 @@ type LockType struct {
 
 // AprEstimate .
 type AprEstimate struct {
-	Low  string `json:"low"`
-	High string `json:"high"`
+	Low  Decimal `json:"low"`
+	High Decimal `json:"high"`
 }
 
 // AutoCompound .
@@ -411,8 +486,8 @@ type Strategy struct {
 	CanDeallocate             bool         `json:"can_deallocate"`
 	DeallocationFee           any          `json:"deallocation_fee"`
 	LockType                  LockType     `json:"lock_type"`
-	UserCap                   string       `json:"user_cap"`
-	UserMinAllocation         string       `json:"user_min_allocation"`
+	UserCap                   Decimal      `json:"user_cap"`
+	UserMinAllocation         Decimal      `json:"user_min_allocation"`
 	YieldSource               YieldSource  `json:"yield_source"`
 }
 
@@ -430,8 +505,8 @@ type StrategyOperationStatus struct {
 // Allocations represents a list of allocations.
 type Allocations struct {
 	ConvertedAsset string            `json:"converted_asset"`
-	TotalAllocated string            `json:"total_allocated"`
-	TotalRewarded  string            `json:"total_rewarded"`
+	TotalAllocated Decimal           `json:"total_allocated"`
+	TotalRewarded  Decimal           `json:"total_rewarded"`
 	NextCursor     string            `json:"next_cursor"`
 	Items          []AllocationsItem `json:"items"`
 }
@@ -440,22 +515,22 @@ type Allocations struct {
 type Allocation struct {
 	CreatedAt time.Time `json:"created_at"`
 	Expires   time.Time `json:"expires"`
-	Native    string    `json:"native"`
-	Converted string    `json:"converted"`
+	Native    Decimal   `json:"native"`
+	Converted Decimal   `json:"converted"`
 }
 
 // AllocationStatus .
 type AllocationStatus struct {
-	Native          string       `json:"native"`
-	Converted       string       `json:"converted"`
+	Native          Decimal      `json:"native"`
+	Converted       Decimal      `json:"converted"`
 	AllocationCount int          `json:"allocation_count"`
 	Allocations     []Allocation `json:"allocations"`
 }
 
 // Total .
 type Total struct {
-	Native    string `json:"native"`
-	Converted string `json:"converted"`
+	Native    Decimal `json:"native"`
+	Converted Decimal `json:"converted"`
 }
 
 // AmountAllocated .
@@ -0,0 +1,437 @@
+package kraken
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ValidationRule identifies the rule an order failed to pass.
+type ValidationRule string
+
+const (
+	// RuleUnknownPair rejects orders on pairs that are not known.
+	RuleUnknownPair ValidationRule = "unknown-pair"
+	// RuleSystemStatus rejects orders the system status does not accept, e.g. in maintenance.
+	RuleSystemStatus ValidationRule = "system-status"
+	// RulePairStatus rejects orders the status of the pair does not accept.
+	RulePairStatus ValidationRule = "pair-status"
+	// RuleRequired rejects orders missing a required field.
+	RuleRequired ValidationRule = "required"
+	// RuleOrderType rejects unknown order types and prices not matching the order type.
+	RuleOrderType ValidationRule = "order-type"
+	// RuleTrigger rejects invalid triggers or triggers on orders that are not triggered.
+	RuleTrigger ValidationRule = "trigger"
+	// RuleOrderFlags rejects invalid or conflicting order flags.
+	RuleOrderFlags ValidationRule = "order-flags"
+	// RuleTimeInForce rejects expiration times not matching the time in force.
+	RuleTimeInForce ValidationRule = "time-in-force"
+	// RuleOrderTime rejects start and expiration times relative to now that are not positive.
+	RuleOrderTime ValidationRule = "order-time"
+	// RuleDeadline rejects elapsed deadlines or deadlines too far in the future.
+	RuleDeadline ValidationRule = "deadline"
+	// RuleTickSize rejects prices that are not a multiple of the tick size of the pair.
+	RuleTickSize ValidationRule = "tick-size"
+	// RulePriceDecimals rejects prices with more decimals than the pair allows.
+	RulePriceDecimals ValidationRule = "price-decimals"
+	// RuleLotDecimals rejects volumes with more decimals than the pair allows.
+	RuleLotDecimals ValidationRule = "lot-decimals"
+	// RuleOrderMinimum rejects volumes below the minimum order volume of the pair.
+	RuleOrderMinimum ValidationRule = "order-minimum"
+	// RuleCostMinimum rejects orders costing less than the minimum cost of the pair.
+	RuleCostMinimum ValidationRule = "cost-minimum"
+	// RuleLeverage rejects leverages not available on the pair.
+	RuleLeverage ValidationRule = "leverage"
+	// RuleInvalidDecimal rejects prices and volumes that are not valid numbers.
+	RuleInvalidDecimal ValidationRule = "invalid-decimal"
+)
+
+// ValidationError is returned when an order is rejected locally by an OrderValidator.
+// It wraps the error Kraken would have returned for the same order, e.g. ErrInvalidPrice,
+// so that it can be handled the same way with errors.Is.
+type ValidationError struct {
+	Rule   ValidationRule
+	Field  string
+	Reason string
+
+	err error
+}
+
+// Error returns the rule, the field and the reason the order was rejected.
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("invalid order: %s: %s", e.Rule, e.Reason)
+	}
+	return fmt.Sprintf("invalid order: %s: %s %s", e.Rule, e.Field, e.Reason)
+}
+
+// Unwrap returns the Kraken error matching the failed rule.
+func (e *ValidationError) Unwrap() error {
+	return e.err
+}
+
+// OrderValidator checks orders against the rules of their asset pair and the system status
+// before they are sent to Kraken. It is safe for concurrent use.
+type OrderValidator struct {
+	// AutoRound rounds prices to the tick size of the pair and truncates volumes to its
+	// lot decimals instead of rejecting them.
+	AutoRound bool
+
+	mu       sync.RWMutex
+	pairs    AssetPairs
+	resolver *Resolver
+	status   Status
+}
+
+// NewOrderValidator returns a new OrderValidator for the given asset pairs,
+// e.g. the result of MarketData.TradableAssetPairs or KnownAssetPairs.
+func NewOrderValidator(pairs AssetPairs) *OrderValidator {
+	v := &OrderValidator{}
+	v.SetPairs(pairs)
+	return v
+}
+
+// SetPairs replaces the cached asset pairs. Orders may name the pairs as the Resolver
+// does with KnownAssets, e.g. XBTUSD, XBT/USD or BTC/USD for XXBTZUSD.
+func (v *OrderValidator) SetPairs(pairs AssetPairs) {
+	r := NewResolver(KnownAssets, pairs)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.pairs = pairs
+	v.resolver = r
+}
+
+// SetSystemStatus replaces the cached system status.
+func (v *OrderValidator) SetSystemStatus(status Status) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.status = status
+}
+
+// Refresh reloads the asset pairs and the system status from Kraken.
+func (v *OrderValidator) Refresh(ctx context.Context, m MarketDataService) error {
+	pairs, err := m.TradableAssetPairs(ctx, TradableAssetPairsOpts{})
+	if err != nil {
+		return err
+	}
+
+	status, err := m.SystemStatus(ctx)
+	if err != nil {
+		return err
+	}
+
+	v.SetPairs(pairs)
+	v.SetSystemStatus(status.Status)
+
+	return nil
+}
+
+// Validate checks the order and returns it, rounded if AutoRound is set.
+// A *ValidationError explains the first rule the order failed.
+func (v *OrderValidator) Validate(opts AddOrderOpts) (AddOrderOpts, error) {
+	v.mu.RLock()
+	status := v.status
+	var (
+		pair  AssetPair
+		found bool
+	)
+	if v.resolver != nil {
+		pair, found = v.resolver.Pair(opts.Pair)
+	}
+	info := v.pairs[pair]
+	v.mu.RUnlock()
+
+	if opts.Pair == "" {
+		return opts, invalid(RuleRequired, "pair", "is required", ErrInvalidArguments)
+	}
+	if !found {
+		return opts, invalid(RuleUnknownPair, "pair", fmt.Sprintf("%q is not a known asset pair", opts.Pair), ErrUnknownAssetPair)
+	}
+
+	if err := checkStatus(RuleSystemStatus, "system", status, opts); err != nil {
+		return opts, err
+	}
+	if err := checkStatus(RulePairStatus, opts.Pair, info.Status, opts); err != nil {
+		return opts, err
+	}
+
+	if err := checkOrderType(opts); err != nil {
+		return opts, err
+	}
+
+	if err := checkDeadline(opts.Deadline, time.Now()); err != nil {
+		return opts, err
+	}
+
+	if err := checkLeverage(info, opts); err != nil {
+		return opts, err
+	}
+
+	// Settle-position orders close the whole position, their volume is not checked.
+	if opts.OrderType == SettlePosition {
+		return opts, nil
+	}
+
+	var err error
+	if opts.Price, err = v.checkPrice(info, "price", opts.Price); err != nil {
+		return opts, err
+	}
+	if opts.Price2, err = v.checkPrice(info, "price2", opts.Price2); err != nil {
+		return opts, err
+	}
+
+	// With viqc, the volume is expressed in the quote asset, so it's the cost of the order:
+	// the base asset lot decimals and minimum volume do not apply.
+	if opts.OrderFlags.Has(OrderFlagVolumeInQuote) {
+		if err := checkQuoteVolume(info, opts.Volume); err != nil {
+			return opts, err
+		}
+		return opts, nil
+	}
+
+	if opts.Volume, err = v.checkVolume(info, opts.Volume); err != nil {
+		return opts, err
+	}
+
+	if price, ok := limitPrice(opts); ok && info.CostMin.Sign() > 0 {
+		if cost := price.Mul(opts.Volume); cost.Cmp(info.CostMin) < 0 {
+			return opts, invalid(RuleCostMinimum, "cost", fmt.Sprintf("%s is below the minimum %s", cost, info.CostMin), ErrCostMinimumNotMet)
+		}
+	}
+
+	return opts, nil
+}
+
+func (v *OrderValidator) checkPrice(info AssetPairInfo, field string, p OrderPrice) (OrderPrice, error) {
+	price, ok := p.Decimal()
+	if p == "" || !ok {
+		return p, nil
+	}
+
+	if !price.Valid() {
+		return p, invalid(RuleInvalidDecimal, field, fmt.Sprintf("%q is not a number", price), ErrInvalidArguments)
+	}
+
+	rounded := info.RoundPrice(price)
+	if rounded.Equal(price) {
+		return p, nil
+	}
+	if v.AutoRound {
+		return AbsolutePrice(rounded), nil
+	}
+
+	if info.TickSize.Sign() > 0 {
+		return p, invalid(RuleTickSize, field, fmt.Sprintf("%s is not a multiple of the tick size %s", price, info.TickSize), ErrInvalidPrice)
+	}
+	return p, invalid(RulePriceDecimals, field, fmt.Sprintf("%s has more than %d decimals", price, info.PairDecimals), ErrInvalidPrice)
+}
+
+func (v *OrderValidator) checkVolume(info AssetPairInfo, volume Decimal) (Decimal, error) {
+	if err := checkPositiveVolume(volume); err != nil {
+		return volume, err
+	}
+
+	if rounded := info.RoundVolume(volume); !rounded.Equal(volume) {
+		if !v.AutoRound {
+			return volume, invalid(RuleLotDecimals, "volume", fmt.Sprintf("%s has more than %d decimals", volume, info.LotDecimals), ErrInvalidArguments)
+		}
+		volume = rounded
+	}
+
+	if info.OrderMin.Sign() > 0 && volume.Cmp(info.OrderMin) < 0 {
+		return volume, invalid(RuleOrderMinimum, "volume", fmt.Sprintf("%s is below the minimum %s", volume, info.OrderMin), ErrOrderMinimumNotMet)
+	}
+
+	return volume, nil
+}
+
+// limitPrice returns the absolute limit price of the order, e.g. price2 in stop-loss-limit
+// orders. It returns false if the order has no limit price or if it is relative.
+func limitPrice(opts AddOrderOpts) (Decimal, bool) {
+	switch opts.OrderType {
+	case Limit:
+		return opts.Price.Decimal()
+	case StopLossLimit, TakeProfitLimit, TrailingStopLimit:
+		return opts.Price2.Decimal()
+	}
+	return "", false
+}
+
+// checkQuoteVolume checks a volume expressed in the quote asset against the minimum cost of the pair.
+func checkQuoteVolume(info AssetPairInfo, volume Decimal) error {
+	if err := checkPositiveVolume(volume); err != nil {
+		return err
+	}
+
+	if info.CostMin.Sign() > 0 && volume.Cmp(info.CostMin) < 0 {
+		return invalid(RuleCostMinimum, "volume", fmt.Sprintf("%s is below the minimum cost %s", volume, info.CostMin), ErrCostMinimumNotMet)
+	}
+
+	return nil
+}
+
+// checkPositiveVolume checks that the volume is set and is a positive number.
+func checkPositiveVolume(volume Decimal) error {
+	if volume == "" {
+		return invalid(RuleRequired, "volume", "is required", ErrInvalidArguments)
+	}
+	if !volume.Valid() || volume.Sign() <= 0 {
+		return invalid(RuleInvalidDecimal, "volume", fmt.Sprintf("%q is not a positive number", volume), ErrInvalidArguments)
+	}
+	return nil
+}
+
+// checkStatus checks that the status of the system or the pair accepts the order.
+func checkStatus(rule ValidationRule, name string, status Status, opts AddOrderOpts) error {
+	switch status {
+	case Maintenance:
+		return invalid(rule, "", fmt.Sprintf("%s is in maintenance", name), ErrServiceUnavailable)
+	case CancelOnly:
+		return invalid(rule, "", fmt.Sprintf("%s only accepts cancellations", name), ErrMarketCancelOnly)
+	case PostOnly:
+		if opts.OrderType != Limit || !opts.OrderFlags.Has(OrderFlagPost) {
+			return invalid(rule, "", fmt.Sprintf("%s only accepts post-only limit orders", name), ErrMarketPostOnly)
+		}
+	case LimitOnly:
+		if opts.OrderType != Limit {
+			return invalid(rule, "", fmt.Sprintf("%s only accepts limit orders", name), ErrInvalidArguments)
+		}
+	case ReduceOnly:
+		if !opts.ReduceOnly {
+			return invalid(rule, "", fmt.Sprintf("%s only accepts reduce-only orders", name), ErrInvalidArguments)
+		}
+	}
+	return nil
+}
+
+// checkOrderType checks the prices and the trigger required by the order type.
+func checkOrderType(opts AddOrderOpts) error {
+	var needsPrice, needsPrice2, triggered bool
+
+	switch opts.OrderType {
+	case "":
+		return invalid(RuleRequired, "ordertype", "is required", ErrInvalidArguments)
+	case Market, SettlePosition:
+	case Limit:
+		needsPrice = true
+	case StopLoss, TakeProfit, TrailingStop:
+		needsPrice, triggered = true, true
+	case StopLossLimit, TakeProfitLimit, TrailingStopLimit:
+		needsPrice, needsPrice2, triggered = true, true, true
+	default:
+		return invalid(RuleOrderType, "ordertype", fmt.Sprintf("%q is not a known order type", opts.OrderType), ErrInvalidArguments)
+	}
+
+	if opts.Type != Buy && opts.Type != Sell {
+		return invalid(RuleRequired, "type", "must be buy or sell", ErrInvalidArguments)
+	}
+
+	switch {
+	case needsPrice && opts.Price == "":
+		return invalid(RuleOrderType, "price", fmt.Sprintf("is required by %s orders", opts.OrderType), ErrInvalidArguments)
+	case !needsPrice && opts.Price != "":
+		return invalid(RuleOrderType, "price", fmt.Sprintf("is not allowed in %s orders", opts.OrderType), ErrInvalidArguments)
+	case needsPrice2 && opts.Price2 == "":
+		return invalid(RuleOrderType, "price2", fmt.Sprintf("is required by %s orders", opts.OrderType), ErrInvalidArguments)
+	case !needsPrice2 && opts.Price2 != "":
+		return invalid(RuleOrderType, "price2", fmt.Sprintf("is not allowed in %s orders", opts.OrderType), ErrInvalidArguments)
+	case !triggered && opts.Trigger != "":
+		return invalid(RuleTrigger, "trigger", fmt.Sprintf("is not allowed in %s orders", opts.OrderType), ErrInvalidArguments)
+	case opts.Trigger != "" && opts.Trigger != Index && opts.Trigger != Last:
+		return invalid(RuleTrigger, "trigger", fmt.Sprintf("%q must be index or last", opts.Trigger), ErrInvalidArguments)
+	}
+
+	if err := opts.OrderFlags.check(); err != nil {
+		return invalid(RuleOrderFlags, "oflags", err.Error(), ErrInvalidArguments)
+	}
+
+	if err := opts.Starttm.check("starttm"); err != nil {
+		return err
+	}
+	if err := opts.Expiretm.check("expiretm"); err != nil {
+		return err
+	}
+
+	switch {
+	case opts.TimeInForce == GoodTillDate && opts.Expiretm.IsZero():
+		return invalid(RuleTimeInForce, "expiretm", "is required by GTD orders", ErrInvalidArguments)
+	case opts.TimeInForce != GoodTillDate && !opts.Expiretm.IsZero():
+		return invalid(RuleTimeInForce, "expiretm", "is only allowed in GTD orders", ErrInvalidArguments)
+	}
+
+	// Trailing stops are triggered at an offset from the best price, and their limit price
+	// is an offset from the trigger price.
+	if opts.OrderType == TrailingStop || opts.OrderType == TrailingStopLimit {
+		if !strings.HasPrefix(string(opts.Price), "+") {
+			return invalid(RuleOrderType, "price", fmt.Sprintf("%q must be a + offset in %s orders", opts.Price, opts.OrderType), ErrInvalidArguments)
+		}
+		if opts.OrderType == TrailingStopLimit && (!opts.Price2.IsRelative() || strings.HasPrefix(string(opts.Price2), "#")) {
+			return invalid(RuleOrderType, "price2", fmt.Sprintf("%q must be a + or - offset in %s orders", opts.Price2, opts.OrderType), ErrInvalidArguments)
+		}
+	}
+
+	return nil
+}
+
+// checkLeverage checks that the leverage is available for the direction of the order.
+func checkLeverage(info AssetPairInfo, opts AddOrderOpts) error {
+	if opts.Leverage == "" || opts.Leverage == "none" {
+		if opts.OrderType == SettlePosition {
+			return invalid(RuleLeverage, "leverage", "is required by settle-position orders", ErrInvalidArguments)
+		}
+		return nil
+	}
+
+	value, _, _ := strings.Cut(opts.Leverage, ":")
+	leverage, err := strconv.Atoi(value)
+	if err != nil {
+		return invalid(RuleLeverage, "leverage", fmt.Sprintf("%q is not a valid leverage", opts.Leverage), ErrInvalidArguments)
+	}
+
+	// Pairs without leverage data, e.g. KnownAssetPairs, are left for Kraken to check.
+	if len(info.LeverageBuy) == 0 && len(info.LeverageSell) == 0 {
+		return nil
+	}
+
+	allowed := info.LeverageBuy
+	if opts.Type == Sell {
+		allowed = info.LeverageSell
+	}
+
+	for _, l := range allowed {
+		if l == leverage {
+			return nil
+		}
+	}
+
+	return invalid(RuleLeverage, "leverage", fmt.Sprintf("%s is not available for %s orders, allowed: %v", opts.Leverage, opts.Type, allowed), ErrInvalidArguments)
+}
+
+// checkDeadline checks that the deadline has not elapsed and is at most MaxOrderDeadline after now.
+func checkDeadline(deadline OrderDeadline, now time.Time) error {
+	switch {
+	case deadline.IsZero():
+		return nil
+	case !deadline.After(now):
+		return invalid(RuleDeadline, "deadline", "has elapsed", ErrDeadlineElapsed)
+	case deadline.Sub(now) > MaxOrderDeadline:
+		return invalid(RuleDeadline, "deadline", fmt.Sprintf("is more than %v in the future", MaxOrderDeadline), ErrInvalidArguments)
+	}
+	return nil
+}
+
+func invalid(rule ValidationRule, field, reason string, err error) *ValidationError {
+	return &ValidationError{Rule: rule, Field: field, Reason: reason, err: err}
+}
+
+// WithOrderValidator sets the OrderValidator that checks orders before they are placed.
+func (c *Client) WithOrderValidator(v *OrderValidator) *Client {
+	c.orderValidator = v
+	return c
+}
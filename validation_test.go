@@ -0,0 +1,369 @@
+package kraken
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func testPairs() AssetPairs {
+	return AssetPairs{
+		XXBTZUSD: {
+			Altname:      "XBTUSD",
+			WSName:       "XBT/USD",
+			PairDecimals: 1,
+			LotDecimals:  8,
+			OrderMin:     "0.0001",
+			CostMin:      "0.5",
+			TickSize:     "0.1",
+			LeverageBuy:  []int{2, 3},
+			LeverageSell: []int{2},
+			Status:       Online,
+		},
+		XETHZUSD: {
+			Altname:      "ETHUSD",
+			PairDecimals: 2,
+			LotDecimals:  8,
+			OrderMin:     "0.01",
+			Status:       CancelOnly,
+		},
+	}
+}
+
+func TestOrderValidator_Validate(t *testing.T) {
+	limit := AddOrderOpts{Pair: "XBTUSD", Type: Buy, OrderType: Limit, Price: "30300.1", Volume: "0.5"}
+
+	with := func(f func(o *AddOrderOpts)) AddOrderOpts {
+		o := limit
+		f(&o)
+		return o
+	}
+
+	tests := []struct {
+		name      string
+		pairs     AssetPairs
+		opts      AddOrderOpts
+		autoRound bool
+		status    Status
+		want      AddOrderOpts
+		wantRule  ValidationRule
+		wantErr   error
+	}{
+		{
+			name: "valid limit order",
+			opts: limit,
+			want: limit,
+		},
+		{
+			name: "valid stop loss limit order",
+			opts: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price2, o.Trigger = StopLossLimit, "30200.0", Last
+			}),
+			want: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price2, o.Trigger = StopLossLimit, "30200.0", Last
+			}),
+		},
+		{
+			name: "relative prices are not checked",
+			opts: with(func(o *AddOrderOpts) { o.Price = "+1.25%" }),
+			want: with(func(o *AddOrderOpts) { o.Price = "+1.25%" }),
+		},
+		{
+			name:     "missing pair",
+			opts:     with(func(o *AddOrderOpts) { o.Pair = "" }),
+			wantRule: RuleRequired,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "unknown pair",
+			opts:     with(func(o *AddOrderOpts) { o.Pair = "FOOBAR" }),
+			wantRule: RuleUnknownPair,
+			wantErr:  ErrUnknownAssetPair,
+		},
+		{
+			name:     "system in maintenance",
+			opts:     limit,
+			status:   Maintenance,
+			wantRule: RuleSystemStatus,
+			wantErr:  ErrServiceUnavailable,
+		},
+		{
+			name:     "system in post only without post flag",
+			opts:     limit,
+			status:   PostOnly,
+			wantRule: RuleSystemStatus,
+			wantErr:  ErrMarketPostOnly,
+		},
+		{
+			name:   "system in post only with post flag",
+			opts:   with(func(o *AddOrderOpts) { o.OrderFlags = OrderFlags{OrderFlagFeeInBase, OrderFlagPost} }),
+			status: PostOnly,
+			want:   with(func(o *AddOrderOpts) { o.OrderFlags = OrderFlags{OrderFlagFeeInBase, OrderFlagPost} }),
+		},
+		{
+			name:     "pair in cancel only",
+			opts:     with(func(o *AddOrderOpts) { o.Pair, o.Price = "ETHUSD", "1871.45" }),
+			wantRule: RulePairStatus,
+			wantErr:  ErrMarketCancelOnly,
+		},
+		{
+			name:     "limit order without price",
+			opts:     with(func(o *AddOrderOpts) { o.Price = "" }),
+			wantRule: RuleOrderType,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "market order with price",
+			opts:     with(func(o *AddOrderOpts) { o.OrderType = Market }),
+			wantRule: RuleOrderType,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "stop loss limit without price2",
+			opts:     with(func(o *AddOrderOpts) { o.OrderType = StopLossLimit }),
+			wantRule: RuleOrderType,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "trigger in limit order",
+			opts:     with(func(o *AddOrderOpts) { o.Trigger = Index }),
+			wantRule: RuleTrigger,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "unknown order flag",
+			opts:     with(func(o *AddOrderOpts) { o.OrderFlags = OrderFlags{"fast"} }),
+			wantRule: RuleOrderFlags,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "good till date without expiration",
+			opts:     with(func(o *AddOrderOpts) { o.TimeInForce = GoodTillDate }),
+			wantRule: RuleTimeInForce,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "expiration without good till date",
+			opts:     with(func(o *AddOrderOpts) { o.Expiretm = OrderTimeIn(time.Hour) }),
+			wantRule: RuleTimeInForce,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name: "non-positive expiration",
+			opts: with(func(o *AddOrderOpts) {
+				o.TimeInForce, o.Expiretm = GoodTillDate, OrderTimeIn(-time.Second)
+			}),
+			wantRule: RuleOrderTime,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "elapsed deadline",
+			opts:     with(func(o *AddOrderOpts) { o.Deadline = DeadlineIn(-time.Second) }),
+			wantRule: RuleDeadline,
+			wantErr:  ErrDeadlineElapsed,
+		},
+		{
+			name:     "unknown order type",
+			opts:     with(func(o *AddOrderOpts) { o.OrderType = "iceberg-ish" }),
+			wantRule: RuleOrderType,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "price off tick size",
+			opts:     with(func(o *AddOrderOpts) { o.Price = "30300.17" }),
+			wantRule: RuleTickSize,
+			wantErr:  ErrInvalidPrice,
+		},
+		{
+			name:      "price rounded to tick size",
+			opts:      with(func(o *AddOrderOpts) { o.Price = "30300.17" }),
+			autoRound: true,
+			want:      with(func(o *AddOrderOpts) { o.Price = "30300.2" }),
+		},
+		{
+			name:     "too many lot decimals",
+			opts:     with(func(o *AddOrderOpts) { o.Volume = "0.123456789" }),
+			wantRule: RuleLotDecimals,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:      "volume truncated to lot decimals",
+			opts:      with(func(o *AddOrderOpts) { o.Volume = "0.123456789" }),
+			autoRound: true,
+			want:      with(func(o *AddOrderOpts) { o.Volume = "0.12345678" }),
+		},
+		{
+			name: "market order with volume in quote",
+			opts: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price, o.Volume, o.OrderFlags = Market, "", "25.123456789", OrderFlags{OrderFlagVolumeInQuote}
+			}),
+			want: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price, o.Volume, o.OrderFlags = Market, "", "25.123456789", OrderFlags{OrderFlagVolumeInQuote}
+			}),
+		},
+		{
+			name: "volume in quote below the minimum cost",
+			opts: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price, o.Volume, o.OrderFlags = Market, "", "0.25", OrderFlags{OrderFlagVolumeInQuote}
+			}),
+			wantRule: RuleCostMinimum,
+			wantErr:  ErrCostMinimumNotMet,
+		},
+		{
+			name:     "invalid volume",
+			opts:     with(func(o *AddOrderOpts) { o.Volume = "-1" }),
+			wantRule: RuleInvalidDecimal,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "order minimum",
+			opts:     with(func(o *AddOrderOpts) { o.Volume = "0.00001" }),
+			wantRule: RuleOrderMinimum,
+			wantErr:  ErrOrderMinimumNotMet,
+		},
+		{
+			name:     "cost minimum",
+			opts:     with(func(o *AddOrderOpts) { o.Price, o.Volume = "1.0", "0.0001" }),
+			wantRule: RuleCostMinimum,
+			wantErr:  ErrCostMinimumNotMet,
+		},
+		{
+			name: "cost minimum of stop loss limit order",
+			opts: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price, o.Price2, o.Trigger, o.Volume = StopLossLimit, "30200.0", "1.0", Last, "0.0001"
+			}),
+			wantRule: RuleCostMinimum,
+			wantErr:  ErrCostMinimumNotMet,
+		},
+		{
+			name: "cost minimum of take profit limit order",
+			opts: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price, o.Price2, o.Volume = TakeProfitLimit, "30400.0", "1.0", "0.0001"
+			}),
+			wantRule: RuleCostMinimum,
+			wantErr:  ErrCostMinimumNotMet,
+		},
+		{
+			name:     "cost minimum of iceberg order",
+			opts:     with(func(o *AddOrderOpts) { o.Price, o.Volume, o.DisplayVol = "1.0", "0.0002", "0.0001" }),
+			wantRule: RuleCostMinimum,
+			wantErr:  ErrCostMinimumNotMet,
+		},
+		{
+			name: "relative limit price is not checked against the cost minimum",
+			opts: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price, o.Price2, o.Volume = StopLossLimit, "30200.0", "-30000", "0.0001"
+			}),
+			want: with(func(o *AddOrderOpts) {
+				o.OrderType, o.Price, o.Price2, o.Volume = StopLossLimit, "30200.0", "-30000", "0.0001"
+			}),
+		},
+		{
+			name:  "pair named with asset aliases",
+			pairs: KnownAssetPairs,
+			opts:  with(func(o *AddOrderOpts) { o.Pair = "BTC/USD" }),
+			want:  with(func(o *AddOrderOpts) { o.Pair = "BTC/USD" }),
+		},
+		{
+			name:  "leverage of pairs without leverage data",
+			pairs: KnownAssetPairs,
+			opts:  with(func(o *AddOrderOpts) { o.Leverage = "2" }),
+			want:  with(func(o *AddOrderOpts) { o.Leverage = "2" }),
+		},
+		{
+			name: "available leverage",
+			opts: with(func(o *AddOrderOpts) { o.Leverage = "3:1" }),
+			want: with(func(o *AddOrderOpts) { o.Leverage = "3:1" }),
+		},
+		{
+			name:     "leverage not available for sell orders",
+			opts:     with(func(o *AddOrderOpts) { o.Type, o.Leverage = Sell, "3" }),
+			wantRule: RuleLeverage,
+			wantErr:  ErrInvalidArguments,
+		},
+		{
+			name:     "settle position without leverage",
+			opts:     AddOrderOpts{Pair: "XBTUSD", Type: Sell, OrderType: SettlePosition, Volume: "0"},
+			wantRule: RuleLeverage,
+			wantErr:  ErrInvalidArguments,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pairs := tt.pairs
+			if pairs == nil {
+				pairs = testPairs()
+			}
+
+			v := NewOrderValidator(pairs)
+			v.AutoRound = tt.autoRound
+			v.SetSystemStatus(tt.status)
+
+			got, err := v.Validate(tt.opts)
+
+			var verr *ValidationError
+			if tt.wantRule != "" {
+				if !errors.As(err, &verr) || verr.Rule != tt.wantRule {
+					t.Fatalf("OrderValidator.Validate() error = %v, want rule %v", err, tt.wantRule)
+				}
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("OrderValidator.Validate() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("OrderValidator.Validate() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("OrderValidator.Validate() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTrading_AddOrder_orderValidator(t *testing.T) {
+	var calls int
+
+	apiMock := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		_ = r.ParseForm()
+		if got := r.PostForm.Get("price"); got != "30300.2" {
+			t.Errorf("price = %v, want %v", got, "30300.2")
+		}
+
+		http.ServeFile(w, r, "testdata/add_order.json")
+	}))
+	defer apiMock.Close()
+
+	baseURL, _ := url.Parse(apiMock.URL + "/")
+
+	v := NewOrderValidator(testPairs())
+
+	c := New(apiMock.Client()).WithOrderValidator(v)
+	c.baseURL = baseURL
+
+	opts := AddOrderOpts{Pair: "XBTUSD", Type: Buy, OrderType: Limit, Price: "30300.17", Volume: "0.5"}
+
+	if _, err := c.Trading.AddOrder(context.Background(), opts); !errors.Is(err, ErrInvalidPrice) {
+		t.Errorf("Trading.AddOrder() error = %v, want %v", err, ErrInvalidPrice)
+	}
+	if calls != 0 {
+		t.Errorf("requests = %v, want rejected orders not to be sent", calls)
+	}
+
+	v.AutoRound = true
+
+	if _, err := c.Trading.AddOrder(context.Background(), opts); err != nil {
+		t.Errorf("Trading.AddOrder() error = %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("requests = %v, want %v", calls, 1)
+	}
+}